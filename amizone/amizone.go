@@ -1,7 +1,7 @@
 package amizone
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -12,6 +12,7 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/go-logr/logr"
 	"k8s.io/klog/v2"
 
 	"github.com/ditsuke/go-amizone/amizone/internal"
@@ -50,23 +51,13 @@ const (
 const (
 	classScheduleEndpointDateFormat = "2006-01-02"
 
+	// MaxClassScheduleRangeDays is the longest range of days GetClassScheduleRange fetches classes for at once.
+	MaxClassScheduleRangeDays = 62
+
 	verificationTokenName = "__RequestVerificationToken"
-)
 
-// Errors
-const (
-	ErrBadClient              = "the http client passed must have a cookie jar, or be nil"
-	ErrFailedToVisitPage      = "failed to visit page"
-	ErrFailedToFetchPage      = "failed to fetch page"
-	ErrFailedToReadResponse   = "failed to read response body"
-	ErrFailedLogin            = "failed to login"
-	ErrInvalidCredentials     = ErrFailedLogin + ": invalid credentials"
-	ErrInternalFailure        = "internal failure"
-	ErrFailedToComposeRequest = ErrInternalFailure + ": failed to compose request"
-	ErrFailedToParsePage      = ErrInternalFailure + ": failed to parse page"
-	ErrInvalidMac             = "invalid MAC address passed"
-	ErrNoMacSlots             = "no free wifi mac slots"
-	ErrFailedToRegisterMac    = "failed to register mac address"
+	// maxConcurrentFeedbackSubmissions bounds the feedback forms submitted at once by SubmitFacultyFeedbackHack.
+	maxConcurrentFeedbackSubmissions = 4
 )
 
 type Credentials struct {
@@ -80,6 +71,19 @@ type Credentials struct {
 type Client struct {
 	httpClient  *http.Client
 	credentials *Credentials
+
+	baseURL        string
+	userAgent      string
+	logger         logr.Logger
+	requestTimeout time.Duration
+	loginCooldown  time.Duration
+	retryPolicy    RetryPolicy
+	circuitBreaker *CircuitBreaker
+	limiters       []*Limiter
+	healthMonitor  *HealthMonitor
+	// session is a session to restore in place of logging in, set by WithSession for the constructor.
+	session []byte
+
 	// muLogin is a mutex that protects the lastAttempt and didLogin fields from concurrent access.
 	muLogin struct {
 		sync.Mutex
@@ -88,74 +92,117 @@ type Client struct {
 	}
 }
 
-// DidLogin returns true if the client ever successfully logged in.
+// DidLogin returns true if the client ever successfully logged in, or was created from a session with NewClientFromSession.
 func (a *Client) DidLogin() bool {
 	a.muLogin.Lock()
 	defer a.muLogin.Unlock()
 	return a.muLogin.didLogin
 }
 
+// CircuitState returns the state of the client's CircuitBreaker, or CircuitClosed if it has none.
+func (a *Client) CircuitState() CircuitState {
+	if a.circuitBreaker == nil {
+		return CircuitClosed
+	}
+	return a.circuitBreaker.State()
+}
+
 // NewClient create a new client instance with Credentials passed, then attempts to log in to the website.
 // The *http.Client parameter can be nil, in which case a default client will be created in its place.
 // To get a non-logged in client, pass empty credentials, ala Credentials{}.
-func NewClient(cred Credentials, httpClient *http.Client) (*Client, error) {
+// The client can be further configured by passing ClientOption values, like WithBaseURL or WithLogger.
+func NewClient(cred Credentials, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
+	return NewClientWithContext(context.Background(), cred, httpClient, opts...)
+}
+
+// NewClientWithContext is like NewClient, but the login attempt made by the constructor is bound to ctx.
+func NewClientWithContext(ctx context.Context, cred Credentials, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
+	client := &Client{
+		credentials:   &cred,
+		baseURL:       BaseURL,
+		userAgent:     internal.Firefox99UserAgent,
+		logger:        klog.NewKlogr().WithName("amizone"),
+		loginCooldown: DefaultLoginCooldown,
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	baseURL, err := url.Parse(client.baseURL)
+	if err != nil || !baseURL.IsAbs() || (baseURL.Scheme != "http" && baseURL.Scheme != "https") {
+		client.logger.Error(err, "amizone.NewClient called with a bad base url", "base_url", client.baseURL)
+		return nil, ErrBadBaseURL
+	}
+	client.baseURL = strings.TrimSuffix(baseURL.String(), "/")
+
 	if httpClient == nil {
 		jar, err := cookiejar.New(nil)
 		if err != nil {
-			klog.Error("failed to create cookiejar for the amizone client. this is a bug.")
-			return nil, errors.New(ErrInternalFailure)
+			client.logger.Error(err, "failed to create cookiejar for the amizone client. this is a bug.")
+			return nil, wrapError(ErrInternalFailure, err)
 		}
 		httpClient = &http.Client{Jar: jar}
 	}
 
 	if jar := httpClient.Jar; jar == nil {
-		klog.Error("amizone.NewClient called with a jar-less http client. please pass a client with a non-nil cookie jar")
-		return nil, errors.New(ErrBadClient)
+		client.logger.Error(nil, "amizone.NewClient called with a jar-less http client. please pass a client with a non-nil cookie jar")
+		return nil, ErrBadClient
 	}
+	client.httpClient = httpClient
 
-	client := &Client{
-		httpClient:  httpClient,
-		credentials: &cred,
+	if client.session != nil {
+		err := client.restoreSession(client.session)
+		client.session = nil
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
 	}
 
 	if cred == (Credentials{}) {
 		return client, nil
 	}
 
-	return client, client.login()
+	return client, client.login(ctx)
 }
 
 // login attempts to log in to Amizone with the credentials passed to the Client and a scrapped
 // "__RequestVerificationToken" value.
-func (a *Client) login() error {
+func (a *Client) login(ctx context.Context) error {
 	a.muLogin.Lock()
 	defer a.muLogin.Unlock()
 
-	if time.Since(a.muLogin.lastAttempt) < time.Minute*2 {
+	if time.Since(a.muLogin.lastAttempt) < a.loginCooldown {
 		return nil
 	}
 
+	if err := ctx.Err(); err != nil {
+		return wrapError(ErrFailedLogin, err)
+	}
+
 	// Record our last login attempt so that we can avoid trying again for some time.
 	a.muLogin.lastAttempt = time.Now()
 
 	// Amizone uses a "verification" token for logins -- we try to retrieve this from the login form page
-	getVerificationTokenFromLoginPage := func() string {
-		response, err := a.doRequest(false, http.MethodGet, "/", nil)
+	verificationToken, err := func() (string, error) {
+		response, err := a.doRequest(ctx, false, http.MethodGet, loginRequestEndpoint, nil)
 		if err != nil {
-			klog.Errorf("login: %s", err.Error())
-			return ""
+			return "", err
 		}
 		return parse.VerificationToken(response.Body)
 	}()
-
-	if getVerificationTokenFromLoginPage == "" {
-		klog.Error("login: failed to retrieve verification token from the login page")
-		return fmt.Errorf("%s: %s", ErrFailedLogin, ErrFailedToParsePage)
+	if err != nil {
+		a.logger.Error(err, "login: failed to retrieve verification token from the login page")
+		// A cancelled attempt shouldn't count towards the login throttle.
+		if ctx.Err() != nil {
+			a.muLogin.lastAttempt = time.Time{}
+		}
+		return wrapError(ErrFailedLogin, err)
 	}
 
 	loginRequestData := func() (v url.Values) {
 		v = url.Values{}
-		v.Set(verificationTokenName, getVerificationTokenFromLoginPage)
+		v.Set(verificationTokenName, verificationToken)
 		v.Set("_UserName", a.credentials.Username)
 		v.Set("_Password", a.credentials.Password)
 		v.Set("_QString", "")
@@ -163,36 +210,42 @@ func (a *Client) login() error {
 	}()
 
 	loginResponse, err := a.doRequest(
+		ctx,
 		false,
 		http.MethodPost,
 		loginRequestEndpoint,
 		strings.NewReader(loginRequestData.Encode()),
 	)
 	if err != nil {
-		klog.Warningf("error while making HTTP request to the amizone login page: %s", err.Error())
-		return fmt.Errorf("%s: %w", ErrFailedLogin, err)
+		a.logger.Info("error while making HTTP request to the amizone login page", "error", err.Error())
+		if ctx.Err() != nil {
+			a.muLogin.lastAttempt = time.Time{}
+		}
+		return wrapError(ErrFailedLogin, err)
 	}
 
 	// The login request should redirect our request to the home page with a 302 "found" status code.
 	// If we're instead redirected to the login page, we've failed to log in because of invalid credentials
 	if loginResponse.Request.URL.Path == loginRequestEndpoint {
-		return errors.New(ErrInvalidCredentials)
+		return ErrInvalidCredentials
 	}
 
 	if loggedIn := parse.IsLoggedIn(loginResponse.Body); !loggedIn {
-		klog.Error(
-			"login attempt failed as indicated by parsing the page returned after the login request, while the redirect indicated that it passed." +
+		a.logger.Error(
+			nil,
+			"login attempt failed as indicated by parsing the page returned after the login request, while the redirect indicated that it passed."+
 				" this failure indicates that something broke between Amizone and go-amizone.",
 		)
-		return errors.New(ErrFailedLogin)
+		return ErrFailedLogin
 	}
 
-	if !internal.IsLoggedIn(a.httpClient) {
-		klog.Error(
-			"login attempt failed as indicated by checking the cookies in the http client's cookie jar. this failure indicates that something has broken between" +
+	if !internal.IsLoggedIn(a.httpClient, a.baseURL) {
+		a.logger.Error(
+			nil,
+			"login attempt failed as indicated by checking the cookies in the http client's cookie jar. this failure indicates that something has broken between"+
 				" Amizone and go-amizone, possibly the cookies used by amizone for authentication.",
 		)
-		return errors.New(ErrFailedLogin)
+		return ErrFailedLogin
 	}
 
 	a.muLogin.didLogin = true
@@ -202,16 +255,23 @@ func (a *Client) login() error {
 // GetAttendance retrieves, parses and returns attendance data from Amizone for courses the client user is enrolled in
 // for their latest semester.
 func (a *Client) GetAttendance() (models.AttendanceRecords, error) {
-	response, err := a.doRequest(true, http.MethodGet, attendancePageEndpoint, nil)
+	return a.GetAttendanceWithContext(context.Background())
+}
+
+// GetAttendanceWithContext is like GetAttendance, but the requests made are bound to ctx.
+func (a *Client) GetAttendanceWithContext(ctx context.Context) (models.AttendanceRecords, error) {
+	response, err := a.doRequest(ctx, true, http.MethodGet, attendancePageEndpoint, nil)
 	if err != nil {
-		klog.Warningf("request (attendance): %s", err.Error())
-		return nil, fmt.Errorf("%s: %s", ErrFailedToFetchPage, err.Error())
+		a.logger.Info("request (attendance)", "error", err.Error())
+		return nil, wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageHome, response)
+
 	attendanceRecord, err := parse.Attendance(response.Body)
 	if err != nil {
-		klog.Errorf("parse (attendance): %s", err.Error())
-		return nil, fmt.Errorf("%s: %w", ErrInternalFailure, err)
+		a.logger.Error(err, "parse (attendance)")
+		return nil, wrapError(ErrFailedToParsePage, err)
 	}
 
 	return models.AttendanceRecords(attendanceRecord), nil
@@ -220,16 +280,23 @@ func (a *Client) GetAttendance() (models.AttendanceRecords, error) {
 // GetExaminationResult retrieves, parses and returns a ExaminationResultRecords from Amizone for their latest semester
 // for which the result is available
 func (a *Client) GetCurrentExaminationResult() (*models.ExamResultRecords, error) {
-	response, err := a.doRequest(true, http.MethodGet, currentExaminationResultEndpoint, nil)
+	return a.GetCurrentExaminationResultWithContext(context.Background())
+}
+
+// GetCurrentExaminationResultWithContext is like GetCurrentExaminationResult, but the requests made are bound to ctx.
+func (a *Client) GetCurrentExaminationResultWithContext(ctx context.Context) (*models.ExamResultRecords, error) {
+	response, err := a.doRequest(ctx, true, http.MethodGet, currentExaminationResultEndpoint, nil)
 	if err != nil {
-		klog.Warningf("request (examination-result): %s", err.Error())
-		return nil, fmt.Errorf("%s: %s", ErrFailedToFetchPage, err.Error())
+		a.logger.Info("request (examination-result)", "error", err.Error())
+		return nil, wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageExaminationResult, response)
+
 	examinationResultRecords, err := parse.ExaminationResult(response.Body)
 	if err != nil {
-		klog.Errorf("parse (examination-result): %s", err.Error())
-		return nil, fmt.Errorf("%s: %w", ErrInternalFailure, err)
+		a.logger.Error(err, "parse (examination-result)")
+		return nil, wrapError(ErrFailedToParsePage, err)
 	}
 
 	return examinationResultRecords, nil
@@ -239,20 +306,27 @@ func (a *Client) GetCurrentExaminationResult() (*models.ExamResultRecords, error
 // semesterRef. Semester references should be retrieved through GetSemesters, which returns a list of valid
 // semesters with names and references.
 func (a *Client) GetExaminationResult(semesterRef string) (*models.ExamResultRecords, error) {
+	return a.GetExaminationResultWithContext(context.Background(), semesterRef)
+}
+
+// GetExaminationResultWithContext is like GetExaminationResult, but the requests made are bound to ctx.
+func (a *Client) GetExaminationResultWithContext(ctx context.Context, semesterRef string) (*models.ExamResultRecords, error) {
 	payload := url.Values{
 		"sem": []string{semesterRef},
 	}.Encode()
 
-	response, err := a.doRequest(true, http.MethodPost, examinationResultEndpoint, strings.NewReader(payload))
+	response, err := a.doRequest(ctx, true, http.MethodPost, examinationResultEndpoint, strings.NewReader(payload))
 	if err != nil {
-		klog.Warningf("request (examination-result): %s", err.Error())
-		return nil, fmt.Errorf("%s: %s", ErrFailedToFetchPage, err.Error())
+		a.logger.Info("request (examination-result)", "error", err.Error())
+		return nil, wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageExaminationResult, response)
+
 	examinationResultRecords, err := parse.ExaminationResult(response.Body)
 	if err != nil {
-		klog.Errorf("parse (examination-result): %s", err.Error())
-		return nil, fmt.Errorf("%s: %w", ErrInternalFailure, err)
+		a.logger.Error(err, "parse (examination-result)")
+		return nil, wrapError(ErrFailedToParsePage, err)
 	}
 
 	return examinationResultRecords, nil
@@ -262,8 +336,28 @@ func (a *Client) GetExaminationResult(semesterRef string) (*models.ExamResultRec
 // The date parameter is used to determine which schedule to retrieve, however as Amizone imposes arbitrary limits on the
 // date range, as in scheduled for dates older than some months are not stored by Amizone, we have no way of knowing if a request will succeed.
 func (a *Client) GetClassSchedule(year int, month time.Month, date int) (models.ClassSchedule, error) {
-	timeFrom := time.Date(year, month, date, 0, 0, 0, 0, time.UTC)
-	timeTo := timeFrom.Add(time.Hour * 24)
+	return a.GetClassScheduleWithContext(context.Background(), year, month, date)
+}
+
+// GetClassScheduleWithContext is like GetClassSchedule, but the requests made are bound to ctx.
+func (a *Client) GetClassScheduleWithContext(ctx context.Context, year int, month time.Month, date int) (models.ClassSchedule, error) {
+	day := time.Date(year, month, date, 0, 0, 0, 0, time.UTC)
+	return a.GetClassScheduleRangeWithContext(ctx, day, day)
+}
+
+// GetClassScheduleRange retrieves, parses and returns the classes scheduled on the days from `from` through `to`,
+// inclusive, in a single request. Only the dates of from and to matter. Ranges of more than
+// MaxClassScheduleRangeDays days, or ending before they start, are rejected with ErrInvalidDateRange.
+func (a *Client) GetClassScheduleRange(from, to time.Time) (models.ClassSchedule, error) {
+	return a.GetClassScheduleRangeWithContext(context.Background(), from, to)
+}
+
+// GetClassScheduleRangeWithContext is like GetClassScheduleRange, but the requests made are bound to ctx.
+func (a *Client) GetClassScheduleRangeWithContext(ctx context.Context, from, to time.Time) (models.ClassSchedule, error) {
+	timeFrom, timeTo, err := classScheduleRange(from, to)
+	if err != nil {
+		return nil, err
+	}
 
 	endpoint := fmt.Sprintf(
 		scheduleEndpointTemplate,
@@ -271,37 +365,62 @@ func (a *Client) GetClassSchedule(year int, month time.Month, date int) (models.
 		timeTo.Format(classScheduleEndpointDateFormat),
 	)
 
-	response, err := a.doRequest(true, http.MethodGet, endpoint, nil)
+	response, err := a.doRequest(ctx, true, http.MethodGet, endpoint, nil)
 	if err != nil {
-		klog.Warningf("request (schedule): %s", err.Error())
-		return nil, fmt.Errorf("%s: %s", ErrFailedToFetchPage, err.Error())
+		a.logger.Info("request (schedule)", "error", err.Error())
+		return nil, wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageDiaryEvents, response)
+
 	classSchedule, err := parse.ClassSchedule(response.Body)
 	if err != nil {
-		klog.Errorf("parse (schedule): %s", err.Error())
-		return nil, fmt.Errorf("%s: %w", ErrFailedToParsePage, err)
+		a.logger.Error(err, "parse (schedule)")
+		return nil, wrapError(ErrFailedToParsePage, err)
+	}
+	// Filter classes by start date, since Amizone might also return classes for the dates before/after the range.
+	scheduledClassesInRange := make(models.ClassSchedule, 0, len(classSchedule))
+	for _, class := range classSchedule {
+		if !class.StartTime.Before(timeFrom) && class.StartTime.Before(timeTo) {
+			scheduledClassesInRange = append(scheduledClassesInRange, class)
+		}
 	}
-	// Filter classes by start date, since might also return classes for the dates before/after the target date.
-	scheduledClassesForTargetDate := classSchedule.FilterByDate(timeFrom)
+	return scheduledClassesInRange, nil
+}
 
-	return models.ClassSchedule(scheduledClassesForTargetDate), nil
+// classScheduleRange returns the range of times the classes of the dates from through to start in: from the
+// midnight starting from, to the midnight ending to, in UTC like the wall-clock times of classes. Dates are taken
+// in the locations of from and to.
+func classScheduleRange(from, to time.Time) (time.Time, time.Time, error) {
+	timeFrom := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	timeTo := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	if !timeTo.After(timeFrom) || timeTo.Sub(timeFrom) > MaxClassScheduleRangeDays*24*time.Hour {
+		return time.Time{}, time.Time{}, ErrInvalidDateRange
+	}
+	return timeFrom, timeTo, nil
 }
 
 // GetExamSchedule retrieves, parses and returns exam schedule data from Amizone.
 // Amizone only allows to retrieve the exam schedule for the current semester, and only close to the exam
 // dates once the date sheets are out, so we don't take a parameter here.
 func (a *Client) GetExamSchedule() (*models.ExaminationSchedule, error) {
-	response, err := a.doRequest(true, http.MethodGet, examScheduleEndpoint, nil)
+	return a.GetExamScheduleWithContext(context.Background())
+}
+
+// GetExamScheduleWithContext is like GetExamSchedule, but the requests made are bound to ctx.
+func (a *Client) GetExamScheduleWithContext(ctx context.Context) (*models.ExaminationSchedule, error) {
+	response, err := a.doRequest(ctx, true, http.MethodGet, examScheduleEndpoint, nil)
 	if err != nil {
-		klog.Warningf("request (exam schedule): %s", err.Error())
-		return nil, errors.New(ErrFailedToVisitPage)
+		a.logger.Info("request (exam schedule)", "error", err.Error())
+		return nil, wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageExaminationSchedule, response)
+
 	examSchedule, err := parse.ExaminationSchedule(response.Body)
 	if err != nil {
-		klog.Errorf("parse (exam schedule): %s", err.Error())
-		return nil, fmt.Errorf("%s: %w", ErrInternalFailure, err)
+		a.logger.Error(err, "parse (exam schedule)")
+		return nil, wrapError(ErrFailedToParsePage, err)
 	}
 
 	return (*models.ExaminationSchedule)(examSchedule), nil
@@ -310,16 +429,23 @@ func (a *Client) GetExamSchedule() (*models.ExaminationSchedule, error) {
 // GetSemesters retrieves, parses and returns a SemesterList from Amizone. This list includes all semesters for which
 // information can be retrieved through other semester-specific methods like GetCourses.
 func (a *Client) GetSemesters() (models.SemesterList, error) {
-	response, err := a.doRequest(true, http.MethodGet, currentCoursesEndpoint, nil)
+	return a.GetSemestersWithContext(context.Background())
+}
+
+// GetSemestersWithContext is like GetSemesters, but the requests made are bound to ctx.
+func (a *Client) GetSemestersWithContext(ctx context.Context) (models.SemesterList, error) {
+	response, err := a.doRequest(ctx, true, http.MethodGet, currentCoursesEndpoint, nil)
 	if err != nil {
-		klog.Warningf("request (get semesters): %s", err.Error())
-		return nil, errors.New(ErrFailedToVisitPage)
+		a.logger.Info("request (get semesters)", "error", err.Error())
+		return nil, wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageCourses, response)
+
 	semesters, err := parse.Semesters(response.Body)
 	if err != nil {
-		klog.Errorf("parse (semesters): %s", err.Error())
-		return nil, fmt.Errorf("%s: %w", ErrInternalFailure, err)
+		a.logger.Error(err, "parse (semesters)")
+		return nil, wrapError(ErrFailedToParsePage, err)
 	}
 
 	return (models.SemesterList)(semesters), nil
@@ -329,20 +455,27 @@ func (a *Client) GetSemesters() (models.SemesterList, error) {
 // semesterRef. Semester references should be retrieved through GetSemesters, which returns a list of valid
 // semesters with names and references.
 func (a *Client) GetCourses(semesterRef string) (models.Courses, error) {
+	return a.GetCoursesWithContext(context.Background(), semesterRef)
+}
+
+// GetCoursesWithContext is like GetCourses, but the requests made are bound to ctx.
+func (a *Client) GetCoursesWithContext(ctx context.Context, semesterRef string) (models.Courses, error) {
 	payload := url.Values{
 		"sem": []string{semesterRef},
 	}.Encode()
 
-	response, err := a.doRequest(true, http.MethodPost, coursesEndpoint, strings.NewReader(payload))
+	response, err := a.doRequest(ctx, true, http.MethodPost, coursesEndpoint, strings.NewReader(payload))
 	if err != nil {
-		klog.Warningf("request (get courses): %s", err.Error())
-		return nil, fmt.Errorf("%s: %s", ErrFailedToFetchPage, err.Error())
+		a.logger.Info("request (get courses)", "error", err.Error())
+		return nil, wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageCourses, response)
+
 	courses, err := parse.Courses(response.Body)
 	if err != nil {
-		klog.Errorf("parse (courses): %s", err.Error())
-		return nil, fmt.Errorf("%s: %w", ErrInternalFailure, err)
+		a.logger.Error(err, "parse (courses)")
+		return nil, wrapError(ErrFailedToParsePage, err)
 	}
 
 	return models.Courses(courses), nil
@@ -350,16 +483,23 @@ func (a *Client) GetCourses(semesterRef string) (models.Courses, error) {
 
 // GetCurrentCourses retrieves, parses and returns a SemesterList from Amizone for the most recent semester.
 func (a *Client) GetCurrentCourses() (models.Courses, error) {
-	response, err := a.doRequest(true, http.MethodGet, currentCoursesEndpoint, nil)
+	return a.GetCurrentCoursesWithContext(context.Background())
+}
+
+// GetCurrentCoursesWithContext is like GetCurrentCourses, but the requests made are bound to ctx.
+func (a *Client) GetCurrentCoursesWithContext(ctx context.Context) (models.Courses, error) {
+	response, err := a.doRequest(ctx, true, http.MethodGet, currentCoursesEndpoint, nil)
 	if err != nil {
-		klog.Warningf("request (get current courses): %s", err.Error())
-		return nil, fmt.Errorf("%s: %s", ErrFailedToFetchPage, err.Error())
+		a.logger.Info("request (get current courses)", "error", err.Error())
+		return nil, wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageCourses, response)
+
 	courses, err := parse.Courses(response.Body)
 	if err != nil {
-		klog.Errorf("parse (current courses): %s", err.Error())
-		return nil, fmt.Errorf("%s: %w", ErrInternalFailure, err)
+		a.logger.Error(err, "parse (current courses)")
+		return nil, wrapError(ErrFailedToParsePage, err)
 	}
 
 	return models.Courses(courses), nil
@@ -367,32 +507,46 @@ func (a *Client) GetCurrentCourses() (models.Courses, error) {
 
 // GetUserProfile retrieves, parsed and returns the current user's profile from Amizone.
 func (a *Client) GetUserProfile() (*models.Profile, error) {
-	response, err := a.doRequest(true, http.MethodGet, profileEndpoint, nil)
+	return a.GetUserProfileWithContext(context.Background())
+}
+
+// GetUserProfileWithContext is like GetUserProfile, but the requests made are bound to ctx.
+func (a *Client) GetUserProfileWithContext(ctx context.Context) (*models.Profile, error) {
+	response, err := a.doRequest(ctx, true, http.MethodGet, profileEndpoint, nil)
 	if err != nil {
-		klog.Warningf("request (get profile): %s", err.Error())
-		return nil, fmt.Errorf("%s: %s", ErrFailedToFetchPage, err.Error())
+		a.logger.Info("request (get profile)", "error", err.Error())
+		return nil, wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageIDCard, response)
+
 	profile, err := parse.Profile(response.Body)
 	if err != nil {
-		klog.Errorf("parse (profile): %s", err.Error())
-		return nil, fmt.Errorf("%s: %w", ErrInternalFailure, err)
+		a.logger.Error(err, "parse (profile)")
+		return nil, wrapError(ErrFailedToParsePage, err)
 	}
 
 	return (*models.Profile)(profile), nil
 }
 
 func (a *Client) GetWiFiMacInformation() (*models.WifiMacInfo, error) {
-	response, err := a.doRequest(true, http.MethodGet, getWifiMacsEndpoint, nil)
+	return a.GetWiFiMacInformationWithContext(context.Background())
+}
+
+// GetWiFiMacInformationWithContext is like GetWiFiMacInformation, but the requests made are bound to ctx.
+func (a *Client) GetWiFiMacInformationWithContext(ctx context.Context) (*models.WifiMacInfo, error) {
+	response, err := a.doRequest(ctx, true, http.MethodGet, getWifiMacsEndpoint, nil)
 	if err != nil {
-		klog.Warningf("request (get wifi macs): %s", err.Error())
-		return nil, fmt.Errorf("%s: %s", ErrFailedToFetchPage, err.Error())
+		a.logger.Info("request (get wifi macs)", "error", err.Error())
+		return nil, wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageWifiMacRegistration, response)
+
 	info, err := parse.WifiMacInfo(response.Body)
 	if err != nil {
-		klog.Errorf("parse (wifi macs): %s", err.Error())
-		return nil, fmt.Errorf("%s: %w", ErrInternalFailure, err)
+		a.logger.Error(err, "parse (wifi macs)")
+		return nil, wrapError(ErrFailedToParsePage, err)
 	}
 
 	return (*models.WifiMacInfo)(info), nil
@@ -404,25 +558,30 @@ func (a *Client) GetWiFiMacInformation() (*models.WifiMacInfo, error) {
 // in the GetWifiMacInfo response.
 // TODO: is the bypassLimit functional?
 func (a *Client) RegisterWifiMac(addr net.HardwareAddr, bypassLimit bool) error {
+	return a.RegisterWifiMacWithContext(context.Background(), addr, bypassLimit)
+}
+
+// RegisterWifiMacWithContext is like RegisterWifiMac, but the requests made are bound to ctx.
+func (a *Client) RegisterWifiMacWithContext(ctx context.Context, addr net.HardwareAddr, bypassLimit bool) error {
 	// validate
 	err := validator.ValidateHardwareAddr(addr)
 	if err != nil {
-		return errors.New(ErrInvalidMac)
+		return ErrInvalidMac
 	}
-	wifiInfo, err := a.GetWiFiMacInformation()
+	wifiInfo, err := a.GetWiFiMacInformationWithContext(ctx)
 	if err != nil {
-		klog.Warningf("failure while getting wifi mac info: %s", err.Error())
+		a.logger.Info("failure while getting wifi mac info", "error", err.Error())
 		return err
 	}
 
 	if wifiInfo.IsRegistered(addr) {
-		klog.Infof("wifi already registered.. skipping request")
+		a.logger.V(1).Info("wifi already registered.. skipping request")
 		return nil
 	}
 
 	if !wifiInfo.HasFreeSlot() {
 		if !bypassLimit {
-			return errors.New(ErrNoMacSlots)
+			return ErrNoMacSlots
 		}
 		// Remove the last mac address :)
 		wifiInfo.RegisteredAddresses = wifiInfo.RegisteredAddresses[:len(wifiInfo.RegisteredAddresses)-1]
@@ -443,24 +602,26 @@ func (a *Client) RegisterWifiMac(addr net.HardwareAddr, bypassLimit bool) error
 		payload.Set(fmt.Sprintf("Mac%d", i+1), marshaller.Mac(mac))
 	}
 
-	res, err := a.doRequest(true, http.MethodPost, registerWifiMacsEndpoint, strings.NewReader(payload.Encode()))
+	res, err := a.doRequest(ctx, true, http.MethodPost, registerWifiMacsEndpoint, strings.NewReader(payload.Encode()))
 	if err != nil {
-		klog.Errorf("request (register wifi mac): %s", err.Error())
-		return fmt.Errorf("%s: %s", ErrFailedToFetchPage, err.Error())
+		a.logger.Error(err, "request (register wifi mac)")
+		return wrapError(ErrFailedToFetchPage, err)
 	}
 	// We attempt to verify if the mac was set successfully, but its futile if bypassLimit was used since Amizone only exposes
 	if bypassLimit {
 		return nil
 	}
 
+	a.checkPage(parse.PageWifiMacRegistration, res)
+
 	macs, err := parse.WifiMacInfo(res.Body)
 	if err != nil {
-		klog.Errorf("parse (wifi macs): %s", err.Error())
-		return errors.New(ErrFailedToParsePage)
+		a.logger.Error(err, "parse (wifi macs)")
+		return wrapError(ErrFailedToParsePage, err)
 	}
 	if !macs.IsRegistered(addr) {
-		klog.Errorf("mac not registered: %s", addr.String())
-		return errors.New(ErrFailedToRegisterMac)
+		a.logger.Error(nil, "mac not registered", "mac", addr.String())
+		return ErrFailedToRegisterMac
 	}
 
 	return nil
@@ -469,31 +630,39 @@ func (a *Client) RegisterWifiMac(addr net.HardwareAddr, bypassLimit bool) error
 // RemoveWifiMac removes a mac address from the Amizone mac address registry. If the mac address is not registered in the
 // first place, this function does nothing.
 func (a *Client) RemoveWifiMac(addr net.HardwareAddr) error {
+	return a.RemoveWifiMacWithContext(context.Background(), addr)
+}
+
+// RemoveWifiMacWithContext is like RemoveWifiMac, but the requests made are bound to ctx.
+func (a *Client) RemoveWifiMacWithContext(ctx context.Context, addr net.HardwareAddr) error {
 	err := validator.ValidateHardwareAddr(addr)
 	if err != nil {
-		return errors.New(ErrInvalidMac)
+		return ErrInvalidMac
 	}
 
 	// ! VULN: remove mac addresses registered by anyone if you know the mac/username pair.
 	response, err := a.doRequest(
+		ctx,
 		true,
 		http.MethodGet,
 		fmt.Sprintf(removeWifiMacEndpoint, a.credentials.Username, marshaller.Mac(addr)),
 		nil,
 	)
 	if err != nil {
-		klog.Errorf("request (remove wifi mac): %s", err.Error())
-		return fmt.Errorf("%s: %s", ErrFailedToFetchPage, err.Error())
+		a.logger.Error(err, "request (remove wifi mac)")
+		return wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageWifiMacRegistration, response)
+
 	wifiInfo, err := parse.WifiMacInfo(response.Body)
 	if err != nil {
-		klog.Errorf("parse (wifi macs): %s", err.Error())
-		return errors.New(ErrFailedToParsePage)
+		a.logger.Error(err, "parse (wifi macs)")
+		return wrapError(ErrFailedToParsePage, err)
 	}
 
 	if wifiInfo.IsRegistered(addr) {
-		return errors.New("failed to remove mac address")
+		return ErrFailedToRemoveMac
 	}
 
 	return nil
@@ -505,15 +674,20 @@ func (a *Client) RemoveWifiMac(addr net.HardwareAddr) error {
 // Returns: the number of faculties for which feedback was submitted. Note that this number would be zero
 // if the feedback was already submitted or is not open.
 func (a *Client) SubmitFacultyFeedbackHack(rating int32, queryRating int32, comment string) (int32, error) {
+	return a.SubmitFacultyFeedbackHackWithContext(context.Background(), rating, queryRating, comment)
+}
+
+// SubmitFacultyFeedbackHackWithContext is like SubmitFacultyFeedbackHack, but the requests made are bound to ctx.
+func (a *Client) SubmitFacultyFeedbackHackWithContext(ctx context.Context, rating int32, queryRating int32, comment string) (int32, error) {
 	// Validate
 	if rating > 5 || rating < 1 {
-		return 0, errors.New("invalid rating")
+		return 0, ErrInvalidRating
 	}
 	if queryRating > 3 || queryRating < 1 {
-		return 0, errors.New("invalid query rating")
+		return 0, ErrInvalidQueryRating
 	}
 	if comment == "" {
-		return 0, errors.New("comment cannot be empty")
+		return 0, ErrEmptyComment
 	}
 
 	// Transform queryRating for "higher number is higher rating" semantics (it's the opposite in the form 😭)
@@ -524,26 +698,29 @@ func (a *Client) SubmitFacultyFeedbackHack(rating int32, queryRating int32, comm
 		queryRating = 1
 	}
 
-	facultyPage, err := a.doRequest(true, http.MethodGet, facultyBaseEndpoint, nil)
+	facultyPage, err := a.doRequest(ctx, true, http.MethodGet, facultyBaseEndpoint, nil)
 	if err != nil {
-		klog.Errorf("request (faculty page): %s", err.Error())
-		return 0, fmt.Errorf("%s: %s", ErrFailedToFetchPage, err.Error())
+		a.logger.Error(err, "request (faculty page)")
+		return 0, wrapError(ErrFailedToFetchPage, err)
 	}
 
+	a.checkPage(parse.PageFacultyFeedback, facultyPage)
+
 	feedbackSpecs, err := parse.FacultyFeedback(facultyPage.Body)
 	if err != nil {
-		klog.Errorf("parse (faculty feedback): %s", err.Error())
-		return 0, errors.New(ErrFailedToParsePage)
+		a.logger.Error(err, "parse (faculty feedback)")
+		return 0, wrapError(ErrFailedToParsePage, err)
 	}
 
 	payloadTemplate, err := template.New("facultyFeedback").Parse(facultyFeedbackTpl)
 	if err != nil {
-		klog.Errorf("Error parsing faculty feedback template: %s", err.Error())
-		return 0, errors.New(ErrInternalFailure)
+		a.logger.Error(err, "Error parsing faculty feedback template")
+		return 0, wrapError(ErrInternalFailure, err)
 	}
 
-	// Parallelize feedback submission for max gains 📈
+	// Parallelize feedback submission for max gains 📈, but not so much that Amizone takes offense.
 	wg := sync.WaitGroup{}
+	inFlight := make(chan struct{}, maxConcurrentFeedbackSubmissions)
 	for _, spec := range feedbackSpecs {
 		spec.Set__Rating = fmt.Sprint(rating)
 		spec.Set__Comment = url.QueryEscape(comment)
@@ -552,22 +729,31 @@ func (a *Client) SubmitFacultyFeedbackHack(rating int32, queryRating int32, comm
 		payloadBuilder := strings.Builder{}
 		err = payloadTemplate.Execute(&payloadBuilder, spec)
 		if err != nil {
-			klog.Errorf("Error executing faculty feedback template: %s", err.Error())
-			return 0, fmt.Errorf("error marshalling feedback request: %s", err)
+			a.logger.Error(err, "Error executing faculty feedback template")
+			return 0, wrapError(ErrInternalFailure, err)
 		}
 		wg.Add(1)
+		inFlight <- struct{}{}
 		go func(payload string) {
-			response, err := a.doRequest(true, http.MethodPost, facultyEndpointSubmitEndpoint, strings.NewReader(payload))
+			defer func() {
+				<-inFlight
+				wg.Done()
+			}()
+			response, err := a.doRequest(ctx, true, http.MethodPost, facultyEndpointSubmitEndpoint, strings.NewReader(payload))
 			if err != nil {
-				klog.Errorf("error submitting a faculty feedback: %s", err.Error())
+				a.logger.Error(err, "error submitting a faculty feedback")
+				return
 			}
 			if response.StatusCode != http.StatusOK {
-				klog.Errorf("Unexpected non-200 status code from faculty feedback submission: %d", response.StatusCode)
+				a.logger.Error(nil, "Unexpected non-200 status code from faculty feedback submission", "status_code", response.StatusCode)
 			}
-			wg.Done()
 		}(payloadBuilder.String())
 	}
 
 	wg.Wait()
+	// If the context was cancelled midway, we can't know which submissions went through.
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
 	return int32(len(feedbackSpecs)), nil
 }
@@ -3,10 +3,14 @@
 package amizone_test
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
 	"os"
 	"testing"
 
 	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/cassette"
 	. "github.com/onsi/gomega"
 )
 
@@ -41,8 +45,8 @@ func TestIntegrateNewClient(t *testing.T) {
 			credentials: amizone.Credentials{Username: "this-user-does-not-exist", Password: "neither-does-this-password"},
 			errorMatcher: func(g *GomegaWithT, err error) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrFailedLogin))
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrInvalidCredentials))
+				g.Expect(err).To(MatchError(amizone.ErrFailedLogin))
+				g.Expect(err).To(MatchError(amizone.ErrInvalidCredentials))
 			},
 			clientMatcher: func(g *GomegaWithT, client amizone.ClientInterface) {
 				g.Expect(client).ToNot(BeNil())
@@ -67,3 +71,47 @@ func TestIntegrateAmizone_GetProfile(t *testing.T) {
 	// goal: test that we can get a profile matching
 	// the information in the environment (need to add name, UUID, etc as environment variables)
 }
+
+// TestIntegrateRecordCassette records the flow TestReplayCassettes replays to the cassette in
+// AMIZONE_CASSETTE_DIR. Anonymise the cassette with amizone-anonymise before adding it to testdata/cassettes.
+func TestIntegrateRecordCassette(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := os.Getenv("AMIZONE_CASSETTE_DIR")
+	if dir == "" {
+		t.Skip("AMIZONE_CASSETTE_DIR environment variable is not set")
+	}
+	validUser := os.Getenv("AMIZONE_USERNAME")
+	validPassword := os.Getenv("AMIZONE_PASSWORD")
+	g.Expect(validUser).ToNot(BeEmpty(), "AMIZONE_USERNAME environment variable is not set")
+	g.Expect(validPassword).ToNot(BeEmpty(), "AMIZONE_PASSWORD environment variable is not set")
+
+	recorder, err := cassette.NewRecorder(dir, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	jar, err := cookiejar.New(nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	runRecordedFlow(g, amizone.Credentials{Username: validUser, Password: validPassword}, &http.Client{Jar: jar, Transport: recorder})
+}
+
+// TestIntegrateContract runs the contract suite against the account in AMIZONE_USERNAME and AMIZONE_PASSWORD.
+// Destructive checks, which register and remove a Wi-Fi address, only run with AMIZONE_CONTRACT_DESTRUCTIVE set.
+// The report is written as JSON to AMIZONE_CONTRACT_REPORT, if set, to track the portal's breakage over time.
+func TestIntegrateContract(t *testing.T) {
+	g := NewWithT(t)
+
+	validUser := os.Getenv("AMIZONE_USERNAME")
+	validPassword := os.Getenv("AMIZONE_PASSWORD")
+	g.Expect(validUser).ToNot(BeEmpty(), "AMIZONE_USERNAME environment variable is not set")
+	g.Expect(validPassword).ToNot(BeEmpty(), "AMIZONE_PASSWORD environment variable is not set")
+
+	client, err := amizone.NewClient(amizone.Credentials{Username: validUser, Password: validPassword}, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	report := runContract(client, os.Getenv("AMIZONE_CONTRACT_DESTRUCTIVE") != "")
+	if path := os.Getenv("AMIZONE_CONTRACT_REPORT"); path != "" {
+		encoded, err := json.MarshalIndent(report, "", "  ")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(os.WriteFile(path, append(encoded, '\n'), 0o644)).To(Succeed())
+	}
+	reportContract(t, report)
+}
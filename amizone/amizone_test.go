@@ -1,16 +1,21 @@
 package amizone_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/http/cookiejar"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
 	"gopkg.in/h2non/gock.v1"
 
@@ -93,6 +98,165 @@ func TestNewClient(t *testing.T) {
 	g.Expect(client).ToNot(BeNil())
 }
 
+func TestNewClientWithContext(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	setupNetworking()
+	t.Cleanup(teardown)
+
+	g.Expect(mock.GockRegisterLoginPage()).ToNot(HaveOccurred())
+	g.Expect(mock.GockRegisterLoginRequest()).ToNot(HaveOccurred())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := amizone.NewClientWithContext(ctx, amizone.Credentials{
+		Username: mock.ValidUser,
+		Password: mock.ValidPass,
+	}, nil)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(errors.Is(err, context.Canceled)).To(BeTrue(), "error should wrap context.Canceled")
+
+	// A cancelled login attempt must not throttle a subsequent one.
+	client, err := amizone.NewClientWithContext(context.Background(), amizone.Credentials{
+		Username: mock.ValidUser,
+		Password: mock.ValidPass,
+	}, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client.DidLogin()).To(BeTrue())
+}
+
+func TestClient_GetAttendanceWithContext(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	setupNetworking()
+	t.Cleanup(teardown)
+
+	client := createLoggedInClient(g)
+	g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attendance, err := client.GetAttendanceWithContext(ctx)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(errors.Is(err, context.Canceled)).To(BeTrue(), "error should wrap context.Canceled")
+	g.Expect(attendance).To(BeNil())
+
+	attendance, err = client.GetAttendanceWithContext(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(attendance).To(HaveLen(8))
+}
+
+func TestNewClient_Options(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	const userAgent = "go-amizone-test"
+	serveFile := func(w http.ResponseWriter, file mock.File) {
+		f, err := file.Open()
+		g.Expect(err).ToNot(HaveOccurred())
+		_, _ = io.Copy(w, f)
+	}
+	// A minimal stand-in for Amizone, serving the login flow and a couple of pages.
+	fakeAmizone := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.UserAgent() != userAgent {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/":
+			serveFile(w, mock.LoginPage)
+		case r.Method == http.MethodPost && r.URL.Path == "/":
+			for name, value := range map[string]string{
+				"ASP.NET_SessionId":          mock.SessionID,
+				"__RequestVerificationToken": mock.VerificationToken,
+				".ASPXAUTH":                  mock.AuthCookie,
+			} {
+				http.SetCookie(w, &http.Cookie{Name: name, Value: value, Path: "/"})
+			}
+			http.Redirect(w, r, "/Home", http.StatusFound)
+		case r.URL.Path == "/Home":
+			serveFile(w, mock.HomePageLoggedIn)
+		case r.URL.Path == "/Academics/MyCourses":
+			time.Sleep(200 * time.Millisecond)
+			serveFile(w, mock.CoursesPage)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(fakeAmizone.Close)
+
+	newHttpClient := func() *http.Client {
+		jar, err := cookiejar.New(nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		// An explicit transport keeps us clear of gock's interception of the default transport.
+		return &http.Client{Jar: jar, Transport: &http.Transport{}}
+	}
+	cred := amizone.Credentials{Username: mock.ValidUser, Password: mock.ValidPass}
+
+	client, err := amizone.NewClient(
+		cred,
+		newHttpClient(),
+		amizone.WithBaseURL(fakeAmizone.URL+"/"),
+		amizone.WithUserAgent(userAgent),
+		amizone.WithLogger(logr.Discard()),
+		amizone.WithRequestTimeout(50*time.Millisecond),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client.DidLogin()).To(BeTrue())
+
+	attendance, err := client.GetAttendance()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(attendance).To(HaveLen(8))
+
+	_, err = client.GetSemesters()
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue(), "error should wrap context.DeadlineExceeded")
+
+	_, err = amizone.NewClient(cred, newHttpClient(), amizone.WithBaseURL("s.amizone.net"))
+	g.Expect(err).To(MatchError(amizone.ErrBadBaseURL))
+}
+
+func TestClient_Errors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	setupNetworking()
+	t.Cleanup(teardown)
+
+	client := createLoggedInClient(g)
+
+	t.Run("non-200 status code", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Cleanup(setupNetworking)
+		gock.New(mock.BaseUrl).Get("/Home").Reply(http.StatusServiceUnavailable)
+
+		_, err := client.GetAttendance()
+		g.Expect(err).To(MatchError(amizone.ErrFailedToFetchPage))
+		g.Expect(err).To(MatchError(amizone.ErrNon200StatusCode))
+
+		var httpErr *amizone.HTTPError
+		g.Expect(errors.As(err, &httpErr)).To(BeTrue())
+		g.Expect(httpErr.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		g.Expect(httpErr.Endpoint).To(Equal("/Home"))
+		g.Expect(httpErr.Method).To(Equal(http.MethodGet))
+	})
+
+	t.Run("unexpected page", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Cleanup(setupNetworking)
+		g.Expect(mock.GockRegisterAuthenticatedGet("/Home", mock.CoursesPage)).ToNot(HaveOccurred())
+
+		_, err := client.GetAttendance()
+		g.Expect(err).To(MatchError(amizone.ErrFailedToParsePage))
+		g.Expect(err).To(MatchError(amizone.ErrInternalFailure))
+
+		var parseErr *amizone.ParseError
+		g.Expect(errors.As(err, &parseErr)).To(BeTrue())
+		g.Expect(parseErr.Page).To(Equal("home"))
+		g.Expect(parseErr.Selector).ToNot(BeEmpty())
+	})
+}
+
 // What are your expectations of this function?
 // Login? No. That's not its responsibility.
 // What we do expect is:
@@ -143,7 +307,7 @@ func TestAmizoneClient_GetAttendance(t *testing.T) {
 			},
 			errorMatcher: func(g *WithT, err error) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrFailedLogin))
+				g.Expect(err).To(MatchError(amizone.ErrFailedLogin))
 			},
 		},
 	}
@@ -285,7 +449,7 @@ func TestClient_GetCourses(t *testing.T) {
 			errMatcher: func(g *WithT, err error) {
 				g.Expect(err).To(HaveOccurred())
 				// TODO: verify against error string "not logged in" or something
-				g.Expect(err.Error()).ToNot(ContainSubstring(amizone.ErrFailedToVisitPage))
+				g.Expect(err).ToNot(MatchError(amizone.ErrFailedToVisitPage))
 			},
 		},
 	}
@@ -442,7 +606,7 @@ func TestClient_GetExaminationResult(t *testing.T) {
 			errMatcher: func(g *WithT, err error) {
 				g.Expect(err).To(HaveOccurred())
 				// TODO: verify against error string "not logged in" or something
-				g.Expect(err.Error()).ToNot(ContainSubstring(amizone.ErrFailedToVisitPage))
+				g.Expect(err).ToNot(MatchError(amizone.ErrFailedToVisitPage))
 			},
 		},
 	}
@@ -586,7 +750,7 @@ func TestClient_GetProfile(t *testing.T) {
 			},
 			errMatcher: func(g *WithT, err error) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrFailedLogin))
+				g.Expect(err).To(MatchError(amizone.ErrFailedLogin))
 			},
 		},
 	}
@@ -674,7 +838,8 @@ func TestClient_RegisterWifiMac(t *testing.T) {
 	// Wifi page with only 1/2 MAC slots populated
 	infoOneShot, err := mock.WifiPageOneSlotPopulated.Open()
 	g.Expect(err).ToNot(HaveOccurred())
-	verificationToken := parse.VerificationToken(infoOneShot)
+	verificationToken, err := parse.VerificationToken(infoOneShot)
+	g.Expect(err).ToNot(HaveOccurred())
 
 	testCases := []TestCase[Empty, MacRegistrationArguments]{
 		{
@@ -689,7 +854,7 @@ func TestClient_RegisterWifiMac(t *testing.T) {
 			dataMatcher: DummyMatcher[Empty],
 			errMatcher: func(err error, g *WithT) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrInvalidMac))
+				g.Expect(err).To(MatchError(amizone.ErrInvalidMac))
 			},
 		},
 		{
@@ -698,7 +863,7 @@ func TestClient_RegisterWifiMac(t *testing.T) {
 			dataMatcher: DummyMatcher[Empty],
 			errMatcher: func(err error, g *WithT) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrNoMacSlots))
+				g.Expect(err).To(MatchError(amizone.ErrNoMacSlots))
 			},
 			setup: func(g *WithT) {
 				g.Expect(mock.GockRegisterWifiInfo()).ToNot(HaveOccurred())
@@ -779,7 +944,7 @@ func TestClient_RegisterWifiMac(t *testing.T) {
 			dataMatcher: DummyMatcher[Empty],
 			errMatcher: func(err error, g *WithT) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrFailedLogin))
+				g.Expect(err).To(MatchError(amizone.ErrFailedLogin))
 			},
 		},
 	}
@@ -816,7 +981,7 @@ func TestClient_RemoveWifiMac(t *testing.T) {
 			input:  MacRemovalArguments{address: net.HardwareAddr{}},
 			errMatcher: func(err error, g *WithT) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrInvalidMac))
+				g.Expect(err).To(MatchError(amizone.ErrInvalidMac))
 			},
 			dataMatcher: DummyMatcher[Empty],
 		},
@@ -827,7 +992,7 @@ func TestClient_RemoveWifiMac(t *testing.T) {
 			input:  MacRemovalArguments{address: parseMacAddress(mock.ValidMac1, g)},
 			errMatcher: func(err error, g *WithT) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrFailedToVisitPage))
+				g.Expect(err).To(MatchError(amizone.ErrFailedToVisitPage))
 			},
 			dataMatcher: DummyMatcher[Empty],
 		},
@@ -838,7 +1003,7 @@ func TestClient_RemoveWifiMac(t *testing.T) {
 			input:  MacRemovalArguments{address: parseMacAddress(mock.ValidMac1, g)},
 			errMatcher: func(err error, g *WithT) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrFailedLogin))
+				g.Expect(err).To(MatchError(amizone.ErrFailedLogin))
 			},
 			dataMatcher: DummyMatcher[Empty],
 		},
@@ -861,7 +1026,7 @@ func TestClient_RemoveWifiMac(t *testing.T) {
 			input: MacRemovalArguments{address: parseMacAddress(mock.ValidMac2, g)},
 			errMatcher: func(err error, g *WithT) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrFailedToParsePage))
+				g.Expect(err).To(MatchError(amizone.ErrFailedToParsePage))
 			},
 			dataMatcher: DummyMatcher[Empty],
 		},
@@ -926,7 +1091,7 @@ func TestClient_GetClassSchedule(t *testing.T) {
 			input:  standardDate,
 			errMatcher: func(err error, g *WithT) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrFailedLogin))
+				g.Expect(err).To(MatchError(amizone.ErrFailedLogin))
 			},
 			dataMatcher: DummyMatcher[models.ClassSchedule],
 			setup:       DummySetup,
@@ -951,7 +1116,7 @@ func TestClient_GetClassSchedule(t *testing.T) {
 			input:  standardDate,
 			errMatcher: func(err error, g *WithT) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(amizone.ErrFailedToParsePage))
+				g.Expect(err).To(MatchError(amizone.ErrFailedToParsePage))
 			},
 			dataMatcher: DummyMatcher[models.ClassSchedule],
 			setup: func(g *WithT) {
@@ -994,6 +1159,103 @@ func TestClient_GetClassSchedule(t *testing.T) {
 	}
 }
 
+func TestClient_GetClassScheduleRange(t *testing.T) {
+	setupNetworking()
+	t.Cleanup(teardown)
+	g := NewWithT(t)
+
+	type GetClassScheduleRangeArguments = struct {
+		from time.Time
+		to   time.Time
+	}
+
+	loggedInClient := createLoggedInClient(g)
+	nonLoggedInClient := createNonLoggedInClient(g)
+
+	april := func(day int) time.Time { return time.Date(2022, time.April, day, 0, 0, 0, 0, time.UTC) }
+	// The fixture has classes on 12 and 13 April 2022.
+	twoDays := GetClassScheduleRangeArguments{from: april(12), to: april(13)}
+
+	testCases := []TestCase[models.ClassSchedule, GetClassScheduleRangeArguments]{
+		{
+			name:   "client is not logged in",
+			client: nonLoggedInClient,
+			input:  twoDays,
+			errMatcher: func(err error, g *WithT) {
+				g.Expect(err).To(MatchError(amizone.ErrFailedLogin))
+			},
+			dataMatcher: DummyMatcher[models.ClassSchedule],
+			setup:       DummySetup,
+		},
+		{
+			name:   "range ends before it starts",
+			client: loggedInClient,
+			input:  GetClassScheduleRangeArguments{from: april(13), to: april(12)},
+			errMatcher: func(err error, g *WithT) {
+				g.Expect(err).To(MatchError(amizone.ErrInvalidDateRange))
+			},
+			dataMatcher: DummyMatcher[models.ClassSchedule],
+			setup:       DummySetup,
+		},
+		{
+			name:   "range is too long",
+			client: loggedInClient,
+			input:  GetClassScheduleRangeArguments{from: april(1), to: april(1).AddDate(0, 0, amizone.MaxClassScheduleRangeDays)},
+			errMatcher: func(err error, g *WithT) {
+				g.Expect(err).To(MatchError(amizone.ErrInvalidDateRange))
+			},
+			dataMatcher: DummyMatcher[models.ClassSchedule],
+			setup:       DummySetup,
+		},
+		{
+			name:   "classes across the range are fetched in one request",
+			client: loggedInClient,
+			input:  twoDays,
+			errMatcher: func(err error, g *WithT) {
+				g.Expect(err).ToNot(HaveOccurred())
+			},
+			dataMatcher: func(schedule models.ClassSchedule, g *WithT) {
+				g.Expect(schedule).To(HaveLen(10))
+				g.Expect(schedule.FilterByDate(april(12))).To(HaveLen(4))
+				g.Expect(schedule.FilterByDate(april(13))).To(HaveLen(6))
+			},
+			setup: func(g *WithT) {
+				g.Expect(mock.GockRegisterCalendarEndpoint("2022-04-12", "2022-04-14", mock.DiaryEventsJSON)).ToNot(HaveOccurred())
+			},
+		},
+		{
+			name:   "classes outside the range are left out",
+			client: loggedInClient,
+			input:  GetClassScheduleRangeArguments{from: april(13), to: april(13).Add(23 * time.Hour)},
+			errMatcher: func(err error, g *WithT) {
+				g.Expect(err).ToNot(HaveOccurred())
+			},
+			dataMatcher: func(schedule models.ClassSchedule, g *WithT) {
+				g.Expect(schedule).To(HaveLen(6))
+				for _, class := range schedule {
+					g.Expect(class.StartTime.Day()).To(Equal(13))
+				}
+			},
+			setup: func(g *WithT) {
+				g.Expect(mock.GockRegisterCalendarEndpoint("2022-04-13", "2022-04-14", mock.DiaryEventsJSON)).ToNot(HaveOccurred())
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Cleanup(setupNetworking)
+			g := NewWithT(t)
+
+			testCase.sanityCheck(g)
+			testCase.setup(g)
+			classes, err := testCase.client.GetClassScheduleRange(testCase.input.from, testCase.input.to)
+			testCase.errMatcher(err, g)
+			testCase.dataMatcher(classes, g)
+		})
+	}
+}
+
 // Test utilities
 
 // setupNetworking tears down any existing network mocks and sets up gock anew to intercept network
@@ -0,0 +1,191 @@
+package amizonetest
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FaultKind is a kind of fault injected by a FaultyTransport.
+type FaultKind string
+
+const (
+	// FaultLatency delays requests by the Latency of the fault, or until they're cancelled. Latency faults
+	// combine with the other kinds.
+	FaultLatency FaultKind = "latency"
+	// FaultReset fails requests with a connection reset, before they're sent.
+	FaultReset FaultKind = "reset"
+	// FaultStatus fails requests with the Status of the fault, before they're sent.
+	FaultStatus FaultKind = "status"
+	// FaultTruncatedBody cuts the bodies of responses short, so reading them fails with io.ErrUnexpectedEOF.
+	FaultTruncatedBody FaultKind = "truncated_body"
+	// FaultLoginRedirect redirects requests to the login page, like Amizone does when it drops a session.
+	FaultLoginRedirect FaultKind = "login_redirect"
+)
+
+// Fault is a fault a FaultyTransport injects into a fraction of requests.
+type Fault struct {
+	Kind FaultKind
+	// Rate is the probability, from 0 to 1, of the fault being injected into a request.
+	Rate float64
+	// Path restricts the fault to requests with paths starting with it. An empty Path matches all requests.
+	Path string
+	// Max caps the number of times the fault is injected, with zero meaning no cap.
+	Max int
+	// Latency is the delay of FaultLatency faults.
+	Latency time.Duration
+	// Status is the status code of FaultStatus faults. Defaults to http.StatusServiceUnavailable.
+	Status int
+}
+
+// FaultyTransport is an http.RoundTripper injecting faults into the requests it makes, at the rates configured,
+// to test how code built on the amizone package copes with Amizone's frequent outages. Faults are drawn from a
+// seeded source, so runs making the same requests in the same order get the same faults. A FaultyTransport is
+// safe for concurrent use.
+type FaultyTransport struct {
+	next   http.RoundTripper
+	faults []Fault
+
+	mu       sync.Mutex
+	rand     *rand.Rand
+	injected map[FaultKind]int
+	// taken counts the injections of each fault, by index, for Fault.Max.
+	taken []int
+}
+
+// NewFaultyTransport returns a FaultyTransport making requests with next, or http.DefaultTransport if nil, and
+// injecting faults drawn from a source seeded with seed.
+func NewFaultyTransport(next http.RoundTripper, seed int64, faults ...Fault) *FaultyTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &FaultyTransport{
+		next:     next,
+		faults:   faults,
+		rand:     rand.New(rand.NewSource(seed)),
+		injected: make(map[FaultKind]int),
+		taken:    make([]int, len(faults)),
+	}
+}
+
+// Injected returns the number of times faults of kind were injected.
+func (t *FaultyTransport) Injected(kind FaultKind) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.injected[kind]
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *FaultyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	latency, fault := t.draw(req)
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+	if fault == nil {
+		return t.next.RoundTrip(req)
+	}
+
+	switch fault.Kind {
+	case FaultReset:
+		closeBody(req)
+		return nil, fmt.Errorf("amizonetest: injected fault: %w", syscall.ECONNRESET)
+	case FaultStatus:
+		closeBody(req)
+		status := fault.Status
+		if status == 0 {
+			status = http.StatusServiceUnavailable
+		}
+		return newResponse(req, status, http.Header{}, http.StatusText(status)), nil
+	case FaultLoginRedirect:
+		closeBody(req)
+		header := http.Header{}
+		header.Set("Location", "/")
+		return newResponse(req, http.StatusFound, header, ""), nil
+	case FaultTruncatedBody:
+		response, err := t.next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		response.Body = io.NopCloser(io.MultiReader(
+			strings.NewReader(string(body[:len(body)/2])),
+			errReader{io.ErrUnexpectedEOF},
+		))
+		response.ContentLength = -1
+		response.Header.Del("Content-Length")
+		return response, nil
+	}
+	return t.next.RoundTrip(req)
+}
+
+// draw draws the faults to inject into req: the total latency of the latency faults drawn, and the first other
+// fault drawn, if any.
+func (t *FaultyTransport) draw(req *http.Request) (time.Duration, *Fault) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var latency time.Duration
+	var drawn *Fault
+	for i := range t.faults {
+		fault := &t.faults[i]
+		if !strings.HasPrefix(req.URL.Path, fault.Path) || (fault.Kind != FaultLatency && drawn != nil) {
+			continue
+		}
+		if (fault.Max > 0 && t.taken[i] >= fault.Max) || t.rand.Float64() >= fault.Rate {
+			continue
+		}
+		t.taken[i]++
+		t.injected[fault.Kind]++
+		if fault.Kind == FaultLatency {
+			latency += fault.Latency
+			continue
+		}
+		drawn = fault
+	}
+	return latency, drawn
+}
+
+// newResponse returns a response to req with status, header and body.
+func newResponse(req *http.Request, status int, header http.Header, body string) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// closeBody closes the body of req, as RoundTrippers must even if they fail.
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
+
+// errReader is an io.Reader failing with err.
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
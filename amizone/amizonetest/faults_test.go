@@ -0,0 +1,155 @@
+package amizonetest_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+)
+
+func TestFaultyTransport(t *testing.T) {
+	server := amizonetest.NewServer(t, nil)
+	get := func(g *WithT, transport http.RoundTripper, path string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		return (&http.Client{Transport: transport}).Do(req)
+	}
+
+	t.Run("resets", func(t *testing.T) {
+		g := NewWithT(t)
+		transport := amizonetest.NewFaultyTransport(nil, 1, amizonetest.Fault{Kind: amizonetest.FaultReset, Rate: 1})
+		_, err := get(g, transport, "/")
+		g.Expect(errors.Is(err, syscall.ECONNRESET)).To(BeTrue())
+		g.Expect(transport.Injected(amizonetest.FaultReset)).To(Equal(1))
+	})
+
+	t.Run("statuses", func(t *testing.T) {
+		g := NewWithT(t)
+		transport := amizonetest.NewFaultyTransport(nil, 1,
+			amizonetest.Fault{Kind: amizonetest.FaultStatus, Rate: 1, Status: http.StatusBadGateway, Max: 1},
+			amizonetest.Fault{Kind: amizonetest.FaultStatus, Rate: 1},
+		)
+		response, err := get(g, transport, "/")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(response.StatusCode).To(Equal(http.StatusBadGateway))
+		response, err = get(g, transport, "/")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+	})
+
+	t.Run("truncated bodies", func(t *testing.T) {
+		g := NewWithT(t)
+		transport := amizonetest.NewFaultyTransport(nil, 1, amizonetest.Fault{Kind: amizonetest.FaultTruncatedBody, Rate: 1})
+		response, err := get(g, transport, "/")
+		g.Expect(err).ToNot(HaveOccurred())
+		body, err := io.ReadAll(response.Body)
+		g.Expect(err).To(MatchError(io.ErrUnexpectedEOF))
+		g.Expect(body).ToNot(BeEmpty())
+	})
+
+	t.Run("login redirects", func(t *testing.T) {
+		g := NewWithT(t)
+		transport := amizonetest.NewFaultyTransport(nil, 1, amizonetest.Fault{Kind: amizonetest.FaultLoginRedirect, Rate: 1, Path: "/Home"})
+		response, err := get(g, transport, "/Home")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(response.Request.URL.Path).To(Equal("/"))
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		g := NewWithT(t)
+		transport := amizonetest.NewFaultyTransport(nil, 1, amizonetest.Fault{Kind: amizonetest.FaultLatency, Rate: 1, Latency: 50 * time.Millisecond})
+		start := time.Now()
+		_, err := get(g, transport, "/")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = (&http.Client{Transport: transport}).Do(req)
+		g.Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+
+	t.Run("rates and paths", func(t *testing.T) {
+		g := NewWithT(t)
+		transport := amizonetest.NewFaultyTransport(nil, 42, amizonetest.Fault{Kind: amizonetest.FaultStatus, Rate: 0.25, Path: "/Home"})
+		for i := 0; i < 200; i++ {
+			response, err := get(g, transport, "/Home")
+			g.Expect(err).ToNot(HaveOccurred())
+			_ = response.Body.Close()
+			response, err = get(g, transport, "/IDCard")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(response.StatusCode).ToNot(Equal(http.StatusServiceUnavailable))
+			_ = response.Body.Close()
+		}
+		g.Expect(transport.Injected(amizonetest.FaultStatus)).To(BeNumerically("~", 50, 20))
+	})
+}
+
+// TestFaultyTransport_Client checks that amizone.Client recovers from the faults it's meant to.
+func TestFaultyTransport_Client(t *testing.T) {
+	cred := amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword}
+	newClient := func(g *WithT, transport *amizonetest.FaultyTransport, opts ...amizone.ClientOption) *amizone.Client {
+		jar, err := cookiejar.New(nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		server := amizonetest.NewServer(t, nil)
+		client, err := amizone.NewClient(cred, &http.Client{Jar: jar, Transport: transport},
+			append([]amizone.ClientOption{amizone.WithBaseURL(server.URL), amizone.WithLogger(logr.Discard())}, opts...)...)
+		g.Expect(err).ToNot(HaveOccurred())
+		return client
+	}
+	policy := amizone.RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+	t.Run("logs in again when redirected to the login page", func(t *testing.T) {
+		g := NewWithT(t)
+		transport := amizonetest.NewFaultyTransport(nil, 1, amizonetest.Fault{Kind: amizonetest.FaultLoginRedirect, Rate: 1, Path: "/IDCard", Max: 1})
+		client := newClient(g, transport)
+		profile, err := client.GetUserProfile()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(profile.Name).ToNot(BeEmpty())
+		g.Expect(transport.Injected(amizonetest.FaultLoginRedirect)).To(Equal(1))
+	})
+
+	t.Run("retries resets and truncated bodies", func(t *testing.T) {
+		g := NewWithT(t)
+		transport := amizonetest.NewFaultyTransport(nil, 1,
+			amizonetest.Fault{Kind: amizonetest.FaultReset, Rate: 1, Path: "/IDCard", Max: 1},
+			amizonetest.Fault{Kind: amizonetest.FaultTruncatedBody, Rate: 1, Path: "/IDCard", Max: 1},
+		)
+		client := newClient(g, transport, amizone.WithRetryPolicy(policy))
+		_, err := client.GetUserProfile()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(transport.Injected(amizonetest.FaultReset)).To(Equal(1))
+		g.Expect(transport.Injected(amizonetest.FaultTruncatedBody)).To(Equal(1))
+	})
+
+	t.Run("survives intermittent faults", func(t *testing.T) {
+		g := NewWithT(t)
+		transport := amizonetest.NewFaultyTransport(nil, 7,
+			amizonetest.Fault{Kind: amizonetest.FaultStatus, Rate: 0.1, Path: "/IDCard"},
+			amizonetest.Fault{Kind: amizonetest.FaultReset, Rate: 0.1, Path: "/IDCard"},
+			amizonetest.Fault{Kind: amizonetest.FaultTruncatedBody, Rate: 0.1, Path: "/IDCard"},
+		)
+		client := newClient(g, transport, amizone.WithRetryPolicy(amizone.RetryPolicy{
+			MaxRetries: 5, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond,
+		}))
+		for i := 0; i < 30; i++ {
+			_, err := client.GetUserProfile()
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+		g.Expect(transport.Injected(amizonetest.FaultStatus)).To(BeNumerically(">", 0))
+		g.Expect(transport.Injected(amizonetest.FaultReset)).To(BeNumerically(">", 0))
+		g.Expect(transport.Injected(amizonetest.FaultTruncatedBody)).To(BeNumerically(">", 0))
+	})
+}
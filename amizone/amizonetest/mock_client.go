@@ -0,0 +1,276 @@
+// Package amizonetest provides test doubles for code built on the amizone package: MockClient, a mock
+// amizone.ClientInterface, fake Amizone portals, from the fixture-backed Portal to httptest servers
+// behaving as described by a Scenario, and FaultyTransport, which injects Amizone's outages into requests.
+package amizonetest
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+// ErrUnexpectedCall is returned by MockClient methods whose behaviour hasn't been set.
+var ErrUnexpectedCall = errors.New("amizonetest: unexpected call")
+
+// Call is a call made to a MockClient.
+type Call struct {
+	// Method is the name of the method called. Calls to the WithContext variants of methods are recorded
+	// under the name of the plain method, e.g. "GetAttendance" for GetAttendanceWithContext.
+	Method string
+	// Args holds the arguments of the call, excluding the context.
+	Args []any
+}
+
+// MockClient is a mock implementation of amizone.ClientInterface. Its behaviour is set through the func fields,
+// one for each method of the interface; plain methods share the func of their WithContext variant and are
+// called with context.Background(). Methods whose func is nil return ErrUnexpectedCall and zero values.
+// Calls are recorded, and can be inspected with Calls. A MockClient is safe for concurrent use as long as the
+// func fields aren't changed concurrently.
+type MockClient struct {
+	// DidLoginFunc defaults to returning true.
+	DidLoginFunc func() bool
+
+	GetAttendanceFunc               func(ctx context.Context) (models.AttendanceRecords, error)
+	GetCurrentExaminationResultFunc func(ctx context.Context) (*models.ExamResultRecords, error)
+	GetExaminationResultFunc        func(ctx context.Context, semesterRef string) (*models.ExamResultRecords, error)
+	GetClassScheduleFunc            func(ctx context.Context, year int, month time.Month, date int) (models.ClassSchedule, error)
+	GetClassScheduleRangeFunc       func(ctx context.Context, from, to time.Time) (models.ClassSchedule, error)
+	GetExamScheduleFunc             func(ctx context.Context) (*models.ExaminationSchedule, error)
+	GetSemestersFunc                func(ctx context.Context) (models.SemesterList, error)
+	GetCoursesFunc                  func(ctx context.Context, semesterRef string) (models.Courses, error)
+	GetCurrentCoursesFunc           func(ctx context.Context) (models.Courses, error)
+	GetUserProfileFunc              func(ctx context.Context) (*models.Profile, error)
+	GetWiFiMacInformationFunc       func(ctx context.Context) (*models.WifiMacInfo, error)
+	RegisterWifiMacFunc             func(ctx context.Context, addr net.HardwareAddr, bypassLimit bool) error
+	RemoveWifiMacFunc               func(ctx context.Context, addr net.HardwareAddr) error
+	SubmitFacultyFeedbackHackFunc   func(ctx context.Context, rating int32, queryRating int32, comment string) (int32, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Interface compliance constraint for MockClient
+var _ amizone.ClientInterface = &MockClient{}
+
+// ClientFactory returns an amizone.ClientFactoryInterface that returns client regardless of the credentials
+// and options it's called with, for injection into code that creates its own clients.
+func ClientFactory(client amizone.ClientInterface) amizone.ClientFactoryInterface {
+	return func(context.Context, amizone.Credentials, *http.Client, ...amizone.ClientOption) (amizone.ClientInterface, error) {
+		return client, nil
+	}
+}
+
+// Calls returns the calls made to the mock so far, in order.
+func (m *MockClient) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallCount returns the number of calls made to method so far.
+func (m *MockClient) CallCount(method string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	count := 0
+	for _, call := range m.calls {
+		if call.Method == method {
+			count++
+		}
+	}
+	return count
+}
+
+// Reset forgets the calls made to the mock so far.
+func (m *MockClient) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+}
+
+func (m *MockClient) record(method string, args ...any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+func (m *MockClient) DidLogin() bool {
+	m.record("DidLogin")
+	if m.DidLoginFunc == nil {
+		return true
+	}
+	return m.DidLoginFunc()
+}
+
+func (m *MockClient) GetAttendance() (models.AttendanceRecords, error) {
+	return m.GetAttendanceWithContext(context.Background())
+}
+
+func (m *MockClient) GetAttendanceWithContext(ctx context.Context) (models.AttendanceRecords, error) {
+	m.record("GetAttendance")
+	if m.GetAttendanceFunc == nil {
+		return nil, ErrUnexpectedCall
+	}
+	return m.GetAttendanceFunc(ctx)
+}
+
+func (m *MockClient) GetCurrentExaminationResult() (*models.ExamResultRecords, error) {
+	return m.GetCurrentExaminationResultWithContext(context.Background())
+}
+
+func (m *MockClient) GetCurrentExaminationResultWithContext(ctx context.Context) (*models.ExamResultRecords, error) {
+	m.record("GetCurrentExaminationResult")
+	if m.GetCurrentExaminationResultFunc == nil {
+		return nil, ErrUnexpectedCall
+	}
+	return m.GetCurrentExaminationResultFunc(ctx)
+}
+
+func (m *MockClient) GetExaminationResult(semesterRef string) (*models.ExamResultRecords, error) {
+	return m.GetExaminationResultWithContext(context.Background(), semesterRef)
+}
+
+func (m *MockClient) GetExaminationResultWithContext(ctx context.Context, semesterRef string) (*models.ExamResultRecords, error) {
+	m.record("GetExaminationResult", semesterRef)
+	if m.GetExaminationResultFunc == nil {
+		return nil, ErrUnexpectedCall
+	}
+	return m.GetExaminationResultFunc(ctx, semesterRef)
+}
+
+func (m *MockClient) GetClassSchedule(year int, month time.Month, date int) (models.ClassSchedule, error) {
+	return m.GetClassScheduleWithContext(context.Background(), year, month, date)
+}
+
+func (m *MockClient) GetClassScheduleWithContext(ctx context.Context, year int, month time.Month, date int) (models.ClassSchedule, error) {
+	m.record("GetClassSchedule", year, month, date)
+	if m.GetClassScheduleFunc == nil {
+		return nil, ErrUnexpectedCall
+	}
+	return m.GetClassScheduleFunc(ctx, year, month, date)
+}
+
+func (m *MockClient) GetClassScheduleRange(from, to time.Time) (models.ClassSchedule, error) {
+	return m.GetClassScheduleRangeWithContext(context.Background(), from, to)
+}
+
+func (m *MockClient) GetClassScheduleRangeWithContext(ctx context.Context, from, to time.Time) (models.ClassSchedule, error) {
+	m.record("GetClassScheduleRange", from, to)
+	if m.GetClassScheduleRangeFunc == nil {
+		return nil, ErrUnexpectedCall
+	}
+	return m.GetClassScheduleRangeFunc(ctx, from, to)
+}
+
+func (m *MockClient) GetExamSchedule() (*models.ExaminationSchedule, error) {
+	return m.GetExamScheduleWithContext(context.Background())
+}
+
+func (m *MockClient) GetExamScheduleWithContext(ctx context.Context) (*models.ExaminationSchedule, error) {
+	m.record("GetExamSchedule")
+	if m.GetExamScheduleFunc == nil {
+		return nil, ErrUnexpectedCall
+	}
+	return m.GetExamScheduleFunc(ctx)
+}
+
+func (m *MockClient) GetSemesters() (models.SemesterList, error) {
+	return m.GetSemestersWithContext(context.Background())
+}
+
+func (m *MockClient) GetSemestersWithContext(ctx context.Context) (models.SemesterList, error) {
+	m.record("GetSemesters")
+	if m.GetSemestersFunc == nil {
+		return nil, ErrUnexpectedCall
+	}
+	return m.GetSemestersFunc(ctx)
+}
+
+func (m *MockClient) GetCourses(semesterRef string) (models.Courses, error) {
+	return m.GetCoursesWithContext(context.Background(), semesterRef)
+}
+
+func (m *MockClient) GetCoursesWithContext(ctx context.Context, semesterRef string) (models.Courses, error) {
+	m.record("GetCourses", semesterRef)
+	if m.GetCoursesFunc == nil {
+		return nil, ErrUnexpectedCall
+	}
+	return m.GetCoursesFunc(ctx, semesterRef)
+}
+
+func (m *MockClient) GetCurrentCourses() (models.Courses, error) {
+	return m.GetCurrentCoursesWithContext(context.Background())
+}
+
+func (m *MockClient) GetCurrentCoursesWithContext(ctx context.Context) (models.Courses, error) {
+	m.record("GetCurrentCourses")
+	if m.GetCurrentCoursesFunc == nil {
+		return nil, ErrUnexpectedCall
+	}
+	return m.GetCurrentCoursesFunc(ctx)
+}
+
+func (m *MockClient) GetUserProfile() (*models.Profile, error) {
+	return m.GetUserProfileWithContext(context.Background())
+}
+
+func (m *MockClient) GetUserProfileWithContext(ctx context.Context) (*models.Profile, error) {
+	m.record("GetUserProfile")
+	if m.GetUserProfileFunc == nil {
+		return nil, ErrUnexpectedCall
+	}
+	return m.GetUserProfileFunc(ctx)
+}
+
+func (m *MockClient) GetWiFiMacInformation() (*models.WifiMacInfo, error) {
+	return m.GetWiFiMacInformationWithContext(context.Background())
+}
+
+func (m *MockClient) GetWiFiMacInformationWithContext(ctx context.Context) (*models.WifiMacInfo, error) {
+	m.record("GetWiFiMacInformation")
+	if m.GetWiFiMacInformationFunc == nil {
+		return nil, ErrUnexpectedCall
+	}
+	return m.GetWiFiMacInformationFunc(ctx)
+}
+
+func (m *MockClient) RegisterWifiMac(addr net.HardwareAddr, bypassLimit bool) error {
+	return m.RegisterWifiMacWithContext(context.Background(), addr, bypassLimit)
+}
+
+func (m *MockClient) RegisterWifiMacWithContext(ctx context.Context, addr net.HardwareAddr, bypassLimit bool) error {
+	m.record("RegisterWifiMac", addr, bypassLimit)
+	if m.RegisterWifiMacFunc == nil {
+		return ErrUnexpectedCall
+	}
+	return m.RegisterWifiMacFunc(ctx, addr, bypassLimit)
+}
+
+func (m *MockClient) RemoveWifiMac(addr net.HardwareAddr) error {
+	return m.RemoveWifiMacWithContext(context.Background(), addr)
+}
+
+func (m *MockClient) RemoveWifiMacWithContext(ctx context.Context, addr net.HardwareAddr) error {
+	m.record("RemoveWifiMac", addr)
+	if m.RemoveWifiMacFunc == nil {
+		return ErrUnexpectedCall
+	}
+	return m.RemoveWifiMacFunc(ctx, addr)
+}
+
+func (m *MockClient) SubmitFacultyFeedbackHack(rating int32, queryRating int32, comment string) (int32, error) {
+	return m.SubmitFacultyFeedbackHackWithContext(context.Background(), rating, queryRating, comment)
+}
+
+func (m *MockClient) SubmitFacultyFeedbackHackWithContext(ctx context.Context, rating int32, queryRating int32, comment string) (int32, error) {
+	m.record("SubmitFacultyFeedbackHack", rating, queryRating, comment)
+	if m.SubmitFacultyFeedbackHackFunc == nil {
+		return 0, ErrUnexpectedCall
+	}
+	return m.SubmitFacultyFeedbackHackFunc(ctx, rating, queryRating, comment)
+}
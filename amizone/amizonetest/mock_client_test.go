@@ -0,0 +1,72 @@
+package amizonetest_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/models"
+	. "github.com/onsi/gomega"
+)
+
+// TestMockClient_Funcs guards against methods being added to amizone.ClientInterface without a matching func
+// field on MockClient.
+func TestMockClient_Funcs(t *testing.T) {
+	g := NewWithT(t)
+	iface := reflect.TypeOf((*amizone.ClientInterface)(nil)).Elem()
+	mock := reflect.TypeOf(amizonetest.MockClient{})
+	for i := 0; i < iface.NumMethod(); i++ {
+		name := strings.TrimSuffix(iface.Method(i).Name, "WithContext")
+		_, ok := mock.FieldByName(name + "Func")
+		g.Expect(ok).To(BeTrue(), "MockClient has no func for %s", name)
+	}
+}
+
+func TestMockClient(t *testing.T) {
+	t.Run("calls are delegated and recorded", func(t *testing.T) {
+		g := NewWithT(t)
+		var receivedRef string
+		client := &amizonetest.MockClient{
+			GetCoursesFunc: func(_ context.Context, semesterRef string) (models.Courses, error) {
+				receivedRef = semesterRef
+				return models.Courses{{CourseRef: models.CourseRef{Code: "CSE101"}}}, nil
+			},
+		}
+
+		courses, err := client.GetCourses("3")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(courses).To(HaveLen(1))
+		g.Expect(receivedRef).To(Equal("3"))
+
+		_, err = client.GetCoursesWithContext(context.Background(), "4")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(client.Calls()).To(Equal([]amizonetest.Call{
+			{Method: "GetCourses", Args: []any{"3"}},
+			{Method: "GetCourses", Args: []any{"4"}},
+		}))
+		g.Expect(client.CallCount("GetCourses")).To(Equal(2))
+
+		client.Reset()
+		g.Expect(client.Calls()).To(BeEmpty())
+	})
+
+	t.Run("unset methods return ErrUnexpectedCall", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &amizonetest.MockClient{}
+		g.Expect(client.DidLogin()).To(BeTrue())
+		_, err := client.GetAttendance()
+		g.Expect(err).To(MatchError(amizonetest.ErrUnexpectedCall))
+		g.Expect(client.RemoveWifiMac(nil)).To(MatchError(amizonetest.ErrUnexpectedCall))
+	})
+
+	t.Run("the factory returns the mock", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &amizonetest.MockClient{}
+		got, err := amizonetest.ClientFactory(client)(context.Background(), amizone.Credentials{}, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(BeIdenticalTo(client))
+	})
+}
@@ -0,0 +1,490 @@
+package amizonetest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/ditsuke/go-amizone/amizone/internal/marshaller"
+	"github.com/ditsuke/go-amizone/amizone/internal/mock"
+	"github.com/ditsuke/go-amizone/amizone/internal/parse"
+)
+
+// Credentials of the user a Portal is created with if no users are passed to NewPortal.
+const (
+	DefaultUsername = mock.ValidUser
+	DefaultPassword = mock.ValidPass
+)
+
+// Names of the cookies Amizone uses for authentication.
+const (
+	sessionCookie      = "ASP.NET_SessionId"
+	authCookie         = ".ASPXAUTH"
+	verificationCookie = "__RequestVerificationToken"
+)
+
+// Endpoints of the portal, mirroring those of Amizone.
+const (
+	loginEndpoint              = "/"
+	homeEndpoint               = "/Home"
+	diaryEventsEndpoint        = "/Calendar/home/GetDiaryEvents"
+	examScheduleEndpoint       = "/Examination/ExamSchedule"
+	coursesEndpoint            = "/Academics/MyCourses"
+	semesterCoursesEndpoint    = coursesEndpoint + "/CourseListSemWise"
+	idCardEndpoint             = "/IDCard"
+	examResultEndpoint         = "/Examination/Examination"
+	semesterExamResultEndpoint = examResultEndpoint + "/ExaminationListSemWise"
+	wifiEndpoint               = "/RegisterForWifi/mac/MacRegistration"
+	wifiSaveEndpoint           = "/RegisterForWifi/mac/MacRegistrationSave"
+	wifiDeleteEndpoint         = "/RegisterForWifi/mac/Mac1RegistrationDelete"
+	facultyEndpoint            = "/FacultyFeeback/FacultyFeedback"
+	facultySubmitEndpoint      = facultyEndpoint + "/SaveFeedbackRating"
+)
+
+// diaryEventsMaxDays caps the number of days diary events are served for in a single request.
+const diaryEventsMaxDays = 62
+
+// diaryEventsDateFormat is the format of the start and end parameters of the diary events endpoint.
+const diaryEventsDateFormat = "2006-01-02"
+
+// diaryEventTimeFormat is the format of timestamps in diary events.
+const diaryEventTimeFormat = "2006/01/02 03:04:05 PM"
+
+// PortalUser is a user of a Portal.
+type PortalUser struct {
+	Username string
+	Password string
+	// WifiMacs are the Wi-Fi MAC addresses registered for the user initially. If nil, the addresses in the
+	// Wi-Fi page fixture are used.
+	WifiMacs []net.HardwareAddr
+}
+
+// portalUser is the state a Portal keeps for a user.
+type portalUser struct {
+	password            string
+	wifiMacs            []net.HardwareAddr
+	feedbackSubmissions int
+}
+
+// Portal is a fake Amizone portal, serving pages from the fixtures the amizone package is tested with. It
+// implements the login flow with cookie-based sessions for a configurable set of users, and keeps the Wi-Fi
+// MAC addresses registered by each user. Requests to pages without a valid session are redirected to the
+// login page, like Amizone does.
+// Portal is an http.Handler, so it can be served with net/http or httptest. It's safe for concurrent use.
+type Portal struct {
+	mu    sync.Mutex
+	users map[string]*portalUser
+	// sessions maps authentication cookies to the usernames they were issued for.
+	sessions map[string]string
+	// sessionRequests counts the requests made with each session, for sessions limited by sessionRequestLimit.
+	sessionRequests     map[string]int
+	sessionRequestLimit int
+
+	routes map[portalRoute]portalHandler
+	// diaryEvents holds the diary events fixture grouped by day, in chronological order.
+	diaryEvents [][]map[string]any
+	wifiSlots   int
+	defaultMacs []net.HardwareAddr
+}
+
+// NewPortal returns a Portal with users. If no users are passed, the portal has a single user with
+// DefaultUsername and DefaultPassword as credentials.
+func NewPortal(users ...PortalUser) (*Portal, error) {
+	p := &Portal{
+		users:           make(map[string]*portalUser),
+		sessions:        make(map[string]string),
+		sessionRequests: make(map[string]int),
+	}
+
+	wifiInfo, err := parse.WifiMacInfo(mustOpen(mock.WifiPage))
+	if err != nil {
+		return nil, fmt.Errorf("parse wifi fixture: %w", err)
+	}
+	p.wifiSlots = wifiInfo.Slots
+	p.defaultMacs = wifiInfo.RegisteredAddresses
+
+	if p.diaryEvents, err = loadDiaryEvents(); err != nil {
+		return nil, err
+	}
+
+	p.routes = p.newRoutes()
+
+	if len(users) == 0 {
+		users = []PortalUser{{Username: DefaultUsername, Password: DefaultPassword}}
+	}
+	for _, user := range users {
+		p.AddUser(user)
+	}
+	return p, nil
+}
+
+// AddUser adds user to the portal, replacing any existing user with the same username.
+func (p *Portal) AddUser(user PortalUser) {
+	macs := user.WifiMacs
+	if macs == nil {
+		macs = p.defaultMacs
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.users[user.Username] = &portalUser{
+		password: user.Password,
+		wifiMacs: append([]net.HardwareAddr(nil), macs...),
+	}
+}
+
+// ExpireSessions invalidates all sessions, as if they had expired, so that users have to log in again.
+func (p *Portal) ExpireSessions() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.sessions = make(map[string]string)
+	p.sessionRequests = make(map[string]int)
+}
+
+// WifiMacs returns the Wi-Fi MAC addresses registered for username.
+func (p *Portal) WifiMacs(username string) []net.HardwareAddr {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	user, ok := p.users[username]
+	if !ok {
+		return nil
+	}
+	return append([]net.HardwareAddr(nil), user.wifiMacs...)
+}
+
+// FeedbackSubmissions returns the number of faculty feedback forms submitted by username.
+func (p *Portal) FeedbackSubmissions(username string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	user, ok := p.users[username]
+	if !ok {
+		return 0
+	}
+	return user.feedbackSubmissions
+}
+
+// ServeHTTP implements http.Handler for Portal.
+func (p *Portal) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == loginEndpoint {
+		switch r.Method {
+		case http.MethodGet:
+			p.serveLoginPage(w)
+		case http.MethodPost:
+			p.login(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	handler, ok := p.routes[portalRoute{r.Method, r.URL.Path}]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	username, ok := p.authenticate(r)
+	if !ok {
+		http.Redirect(w, r, loginEndpoint, http.StatusFound)
+		return
+	}
+	handler(w, r, username)
+}
+
+// portalHandler handles an authenticated request made by username.
+type portalHandler func(w http.ResponseWriter, r *http.Request, username string)
+
+type portalRoute struct{ method, path string }
+
+// newRoutes returns the handlers for authenticated requests.
+func (p *Portal) newRoutes() map[portalRoute]portalHandler {
+	return map[portalRoute]portalHandler{
+		{http.MethodGet, homeEndpoint}:                p.serveFile(mock.HomePageLoggedIn),
+		{http.MethodGet, diaryEventsEndpoint}:         p.serveDiaryEvents,
+		{http.MethodGet, examScheduleEndpoint}:        p.serveFile(mock.ExaminationScheduleWithLocation),
+		{http.MethodGet, coursesEndpoint}:             p.serveFile(mock.CoursesPage),
+		{http.MethodPost, semesterCoursesEndpoint}:    p.serveFile(mock.CoursesPageSemWise),
+		{http.MethodGet, idCardEndpoint}:              p.serveFile(mock.IDCardPage),
+		{http.MethodGet, examResultEndpoint}:          p.serveFile(mock.ExaminationResultPage),
+		{http.MethodPost, semesterExamResultEndpoint}: p.serveFile(mock.ExaminationResultPage),
+		{http.MethodGet, wifiEndpoint}:                p.serveWifiPage,
+		{http.MethodPost, wifiSaveEndpoint}:           p.saveWifiMacs,
+		{http.MethodGet, wifiDeleteEndpoint}:          p.deleteWifiMac,
+		{http.MethodGet, facultyEndpoint}:             p.serveFile(mock.FacultyPage),
+		{http.MethodPost, facultySubmitEndpoint}:      p.submitFeedback,
+	}
+}
+
+// authenticate returns the user the session of r belongs to, if it has a valid one.
+func (p *Portal) authenticate(r *http.Request) (string, bool) {
+	cookie, err := r.Cookie(authCookie)
+	if err != nil {
+		return "", false
+	}
+	for _, name := range []string{sessionCookie, verificationCookie} {
+		if _, err := r.Cookie(name); err != nil {
+			return "", false
+		}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	username, ok := p.sessions[cookie.Value]
+	if !ok || p.sessionRequestLimit <= 0 {
+		return username, ok
+	}
+	p.sessionRequests[cookie.Value]++
+	if p.sessionRequests[cookie.Value] > p.sessionRequestLimit {
+		delete(p.sessions, cookie.Value)
+		delete(p.sessionRequests, cookie.Value)
+		return "", false
+	}
+	return username, true
+}
+
+func (p *Portal) serveLoginPage(w http.ResponseWriter) {
+	p.serveFile(mock.LoginPage)(w, nil, "")
+}
+
+// login logs users in, redirecting them to the home page with the cookies of a new session on success,
+// and back to the login page otherwise.
+func (p *Portal) login(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	username, password := r.PostForm.Get("_UserName"), r.PostForm.Get("_Password")
+
+	p.mu.Lock()
+	user, ok := p.users[username]
+	valid := ok && user.password == password && r.PostForm.Get(verificationCookie) != ""
+	var auth string
+	if valid {
+		auth = randomToken()
+		p.sessions[auth] = username
+	}
+	p.mu.Unlock()
+
+	if !valid {
+		http.Redirect(w, r, loginEndpoint, http.StatusFound)
+		return
+	}
+	for name, value := range map[string]string{
+		sessionCookie:      randomToken(),
+		verificationCookie: randomToken(),
+		authCookie:         auth,
+	} {
+		http.SetCookie(w, &http.Cookie{Name: name, Value: value, Path: "/", HttpOnly: true})
+	}
+	http.Redirect(w, r, homeEndpoint, http.StatusFound)
+}
+
+// serveFile returns a handler serving file.
+func (p *Portal) serveFile(file mock.File) portalHandler {
+	return func(w http.ResponseWriter, _ *http.Request, _ string) {
+		f, err := file.Open()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = io.Copy(w, f)
+	}
+}
+
+// serveDiaryEvents serves the diary events fixture for every day in the requested range, cycling through the
+// days in the fixture so that every day has classes.
+func (p *Portal) serveDiaryEvents(w http.ResponseWriter, r *http.Request, _ string) {
+	start, end, err := diaryEventsRange(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	events := make([]map[string]any, 0)
+	for day, n := start, 0; day.Before(end) && n < diaryEventsMaxDays; day, n = day.AddDate(0, 0, 1), n+1 {
+		for _, event := range p.diaryEvents[day.YearDay()%len(p.diaryEvents)] {
+			events = append(events, redateEvent(event, day))
+		}
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(events)
+}
+
+// diaryEventsRange returns the range of days diary events are requested for by r.
+func diaryEventsRange(r *http.Request) (start, end time.Time, err error) {
+	start, err = time.Parse(diaryEventsDateFormat, r.URL.Query().Get("start"))
+	if err != nil {
+		return start, end, fmt.Errorf("bad start date: %w", err)
+	}
+	end, err = time.Parse(diaryEventsDateFormat, r.URL.Query().Get("end"))
+	if err != nil {
+		return start, end, fmt.Errorf("bad end date: %w", err)
+	}
+	if end.Before(start) {
+		return start, end, fmt.Errorf("end date before start date")
+	}
+	return start, end, nil
+}
+
+func (p *Portal) serveWifiPage(w http.ResponseWriter, _ *http.Request, username string) {
+	p.writeWifiPage(w, p.WifiMacs(username))
+}
+
+// saveWifiMacs replaces the addresses registered for the user with those submitted, like Amizone does.
+func (p *Portal) saveWifiMacs(w http.ResponseWriter, r *http.Request, username string) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var macs []net.HardwareAddr
+	for i := 1; r.PostForm.Has(fmt.Sprintf("Mac%d", i)); i++ {
+		value := r.PostForm.Get(fmt.Sprintf("Mac%d", i))
+		if value == "" {
+			continue
+		}
+		mac, err := net.ParseMAC(value)
+		if err != nil {
+			http.Error(w, "bad mac address: "+value, http.StatusBadRequest)
+			return
+		}
+		macs = append(macs, mac)
+	}
+
+	p.mu.Lock()
+	p.users[username].wifiMacs = macs
+	p.mu.Unlock()
+	p.writeWifiPage(w, macs)
+}
+
+// deleteWifiMac removes the address passed, peculiarly, as the username parameter.
+func (p *Portal) deleteWifiMac(w http.ResponseWriter, r *http.Request, username string) {
+	target, err := net.ParseMAC(r.URL.Query().Get("username"))
+	if err != nil {
+		http.Error(w, "bad mac address", http.StatusBadRequest)
+		return
+	}
+
+	p.mu.Lock()
+	user := p.users[username]
+	macs := make([]net.HardwareAddr, 0, len(user.wifiMacs))
+	for _, mac := range user.wifiMacs {
+		if !bytes.Equal(mac, target) {
+			macs = append(macs, mac)
+		}
+	}
+	user.wifiMacs = macs
+	p.mu.Unlock()
+	p.writeWifiPage(w, macs)
+}
+
+// writeWifiPage renders the Wi-Fi page fixture with macs in its address slots. Only as many addresses as
+// there are slots are shown, like on Amizone.
+func (p *Portal) writeWifiPage(w http.ResponseWriter, macs []net.HardwareAddr) {
+	dom, err := goquery.NewDocumentFromReader(mustOpen(mock.WifiPage))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for i := 0; i < p.wifiSlots; i++ {
+		input := dom.Find(fmt.Sprintf("input#Mac%d", i+1))
+		deleteLink := input.Closest(".form-group").Find("a[href]")
+		if i >= len(macs) {
+			input.SetAttr("value", "")
+			deleteLink.SetAttr("href", wifiDeleteEndpoint)
+			continue
+		}
+		value := marshaller.Mac(macs[i])
+		input.SetAttr("value", value)
+		deleteLink.SetAttr("href", fmt.Sprintf("%s?Amizone_Id=%s&username=%s", wifiDeleteEndpoint, mock.StudentEnrollmentNumber, value))
+	}
+
+	html, err := dom.Html()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = io.WriteString(w, html)
+}
+
+func (p *Portal) submitFeedback(w http.ResponseWriter, _ *http.Request, username string) {
+	p.mu.Lock()
+	p.users[username].feedbackSubmissions++
+	p.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+// loadDiaryEvents loads the diary events fixture, grouped by day in chronological order.
+func loadDiaryEvents() ([][]map[string]any, error) {
+	var events []map[string]any
+	if err := json.NewDecoder(mustOpen(mock.DiaryEventsJSON)).Decode(&events); err != nil {
+		return nil, fmt.Errorf("decode diary events fixture: %w", err)
+	}
+
+	byDay := make(map[string][]map[string]any)
+	for _, event := range events {
+		start, _ := event["start"].(string)
+		day, _, _ := strings.Cut(start, " ")
+		byDay[day] = append(byDay[day], event)
+	}
+	days := make([]string, 0, len(byDay))
+	for day := range byDay {
+		days = append(days, day)
+	}
+	sort.Strings(days)
+
+	grouped := make([][]map[string]any, 0, len(days))
+	for _, day := range days {
+		grouped = append(grouped, byDay[day])
+	}
+	if len(grouped) == 0 {
+		return nil, fmt.Errorf("diary events fixture has no events")
+	}
+	return grouped, nil
+}
+
+// redateEvent returns a copy of event moved to day, keeping its times.
+func redateEvent(event map[string]any, day time.Time) map[string]any {
+	moved := make(map[string]any, len(event))
+	for k, v := range event {
+		moved[k] = v
+	}
+	for _, key := range []string{"start", "end"} {
+		raw, _ := event[key].(string)
+		t, err := time.Parse(diaryEventTimeFormat, raw)
+		if err != nil {
+			continue
+		}
+		t = time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+		moved[key] = t.Format(diaryEventTimeFormat)
+	}
+	return moved
+}
+
+// mustOpen opens a fixture, which are embedded and can't fail to open unless they're renamed.
+func mustOpen(file mock.File) io.Reader {
+	f, err := file.Open()
+	if err != nil {
+		panic("amizonetest: missing fixture " + string(file) + ": " + err.Error())
+	}
+	return f
+}
+
+// randomToken returns a random token for use as a cookie value.
+func randomToken() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic("amizonetest: failed to generate token: " + err.Error())
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,133 @@
+package amizonetest_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+)
+
+func TestPortal(t *testing.T) {
+	g := NewWithT(t)
+	portal, err := amizonetest.NewPortal(
+		amizonetest.PortalUser{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword},
+		amizonetest.PortalUser{Username: "other", Password: "secret", WifiMacs: []net.HardwareAddr{}},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	server := httptest.NewServer(portal)
+	t.Cleanup(server.Close)
+
+	newClient := func(g *WithT, username, password string) (*amizone.Client, error) {
+		jar, err := cookiejar.New(nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		return amizone.NewClient(
+			amizone.Credentials{Username: username, Password: password},
+			&http.Client{Jar: jar},
+			amizone.WithBaseURL(server.URL),
+			amizone.WithLogger(logr.Discard()),
+			amizone.WithLoginCooldown(0),
+		)
+	}
+
+	t.Run("invalid credentials are rejected", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := newClient(g, amizonetest.DefaultUsername, "wrong")
+		g.Expect(err).To(MatchError(amizone.ErrInvalidCredentials))
+	})
+
+	t.Run("every page parses", func(t *testing.T) {
+		g := NewWithT(t)
+		client, err := newClient(g, amizonetest.DefaultUsername, amizonetest.DefaultPassword)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		attendance, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(attendance).ToNot(BeEmpty())
+
+		schedule, err := client.GetClassSchedule(2023, time.March, 6)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(schedule).ToNot(BeEmpty())
+		for _, class := range schedule {
+			g.Expect(class.StartTime.Format("2006-01-02")).To(Equal("2023-03-06"))
+		}
+
+		examSchedule, err := client.GetExamSchedule()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(examSchedule.Exams).ToNot(BeEmpty())
+
+		semesters, err := client.GetSemesters()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(semesters).ToNot(BeEmpty())
+
+		courses, err := client.GetCourses(semesters[0].Ref)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(courses).ToNot(BeEmpty())
+
+		_, err = client.GetCurrentCourses()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		profile, err := client.GetUserProfile()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(profile.Name).ToNot(BeEmpty())
+
+		result, err := client.GetCurrentExaminationResult()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.CourseWise).ToNot(BeEmpty())
+
+		_, err = client.GetExaminationResult(semesters[0].Ref)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		submitted, err := client.SubmitFacultyFeedbackHack(5, 3, "good")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(submitted).ToNot(BeZero())
+		g.Expect(portal.FeedbackSubmissions(amizonetest.DefaultUsername)).To(BeNumerically("==", submitted))
+	})
+
+	t.Run("wifi registrations are kept per user", func(t *testing.T) {
+		g := NewWithT(t)
+		client, err := newClient(g, "other", "secret")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		info, err := client.GetWiFiMacInformation()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(info.RegisteredAddresses).To(BeEmpty())
+		g.Expect(info.HasFreeSlot()).To(BeTrue())
+
+		addr, err := net.ParseMAC("00:11:22:33:44:55")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(client.RegisterWifiMac(addr, false)).To(Succeed())
+		g.Expect(portal.WifiMacs("other")).To(Equal([]net.HardwareAddr{addr}))
+		g.Expect(portal.WifiMacs(amizonetest.DefaultUsername)).To(HaveLen(2), "other users are unaffected")
+
+		info, err = client.GetWiFiMacInformation()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(info.IsRegistered(addr)).To(BeTrue())
+
+		g.Expect(client.RemoveWifiMac(addr)).To(Succeed())
+		g.Expect(portal.WifiMacs("other")).To(BeEmpty())
+	})
+
+	t.Run("expired sessions are renewed by logging in again", func(t *testing.T) {
+		g := NewWithT(t)
+		client, err := newClient(g, amizonetest.DefaultUsername, amizonetest.DefaultPassword)
+		g.Expect(err).ToNot(HaveOccurred())
+		session, err := client.ExportSession()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		portal.ExpireSessions()
+		_, err = client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		renewed, err := client.ExportSession()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(renewed).ToNot(Equal(session))
+	})
+}
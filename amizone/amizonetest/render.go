@@ -0,0 +1,138 @@
+package amizonetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/ditsuke/go-amizone/amizone/internal/mock"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+// attendanceItemsTpl renders attendance records as items of the attendance widget on the home page.
+var attendanceItemsTpl = template.Must(template.New("attendance").Funcs(template.FuncMap{"percent": attendancePercent}).Parse(`
+{{- range . }}
+<li class="item-green clearfix">
+	<label class="inline">
+		<span class="lbl"><span class="sub-code">{{ .Course.Code }} </span>       {{ .Course.Name }}</span>
+	</label>
+	<div class="pull-right easy-pie-chart percentage" data-size="50" data-percent="{{ percent .Attendance }}">
+		<span class="percent">{{ percent .Attendance }}</span>%
+	</div>
+	<span class="seprator"></span>
+	<div class="pull-right class-count"><span>{{ .Attendance.ClassesAttended }}/{{ .Attendance.ClassesHeld }}  </span></div>
+</li>
+{{- end }}
+`))
+
+// examScheduleTpl renders the examination schedule page.
+var examScheduleTpl = template.Must(template.New("examSchedule").Parse(`<div class="main-content-inner">
+	<div class="breadcrumbs" id="breadcrumbs">
+		<ul class="breadcrumb">
+			<li><i class="ace-icon fa fa-home home-icon"></i><a href="/home">Home</a> </li>
+			<li class="active">Examination</li>
+			<li class="active">Examination Schedule</li>
+		</ul>
+	</div>
+	<div class="page-content">
+		<div class="page-header">
+			<h1>{{ .Title }}</h1>
+		</div>
+		<div id="no-more-tables">
+			<table class="table table-bordered table-condensed">
+				<thead class="cf">
+					<tr>
+						<th><strong>Course Code</strong></th>
+						<th><strong>Course Title</strong></th>
+						<th><strong>Exam Date</strong></th>
+						<th><strong>Exam Time</strong></th>
+						<th><strong>Details</strong></th>
+					</tr>
+				</thead>
+				<tbody>
+				{{- range .Exams }}
+					<tr>
+						<td data-title="Course Code">{{ .Course.Code }}</td>
+						<td data-title="Course Title">{{ .Course.Name }}</td>
+						<td data-title="Exam Date">{{ .Time.Format "02/01/2006" }}</td>
+						<td data-title="Time">{{ .Time.Format "15:04" }}</td>
+						<td data-title="Paper Type"><b>Exam Mode : {{ .Mode }}</b> Regular
+						{{- if .Location }} <b style="color:red"><br/>Location :- {{ .Location }}<br/>Campus Entry :- From Gate No : 4</b>{{ end }}</td>
+					</tr>
+				{{- end }}
+				</tbody>
+			</table>
+		</div>
+	</div>
+</div>
+`))
+
+// attendanceColors maps attendance states to the colors Amizone marks classes in the diary with.
+var attendanceColors = map[models.AttendanceState]string{
+	models.AttendanceStatePending: models.ColorAttendancePending,
+	models.AttendanceStatePresent: models.ColorAttendancePresent,
+	models.AttendanceStateAbsent:  models.ColorAttendanceAbsent,
+	models.AttendanceStateNA:      models.ColorAttendanceNA,
+}
+
+func attendancePercent(attendance models.Attendance) string {
+	if attendance.ClassesHeld == 0 {
+		return "0.00"
+	}
+	return fmt.Sprintf("%.2f", float64(attendance.ClassesAttended)*100/float64(attendance.ClassesHeld))
+}
+
+// renderHomePage renders the home page fixture with records in the attendance widget.
+func renderHomePage(records models.AttendanceRecords) ([]byte, error) {
+	dom, err := goquery.NewDocumentFromReader(mustOpen(mock.HomePageLoggedIn))
+	if err != nil {
+		return nil, err
+	}
+	items := strings.Builder{}
+	if err := attendanceItemsTpl.Execute(&items, records); err != nil {
+		return nil, err
+	}
+	dom.Find("ul#tasks").SetHtml(items.String())
+
+	html, err := dom.Html()
+	return []byte(html), err
+}
+
+// renderExamSchedule renders the examination schedule page for schedule. Amizone titles schedules in
+// uppercase, so the title is uppercased too.
+func renderExamSchedule(schedule models.ExaminationSchedule) ([]byte, error) {
+	schedule.Title = strings.ToUpper(schedule.Title)
+	page := bytes.Buffer{}
+	if err := examScheduleTpl.Execute(&page, schedule); err != nil {
+		return nil, err
+	}
+	return page.Bytes(), nil
+}
+
+// renderDiaryEvents renders the classes of schedule starting on the days from start until end, exclusive, as
+// the diary events Amizone returns.
+func renderDiaryEvents(schedule models.ClassSchedule, start, end time.Time) ([]byte, error) {
+	events := make(models.AmizoneDiaryEvents, 0)
+	for _, class := range schedule {
+		day := time.Date(class.StartTime.Year(), class.StartTime.Month(), class.StartTime.Day(), 0, 0, 0, 0, time.UTC)
+		if day.Before(start) || !day.Before(end) {
+			continue
+		}
+		events = append(events, models.AmizoneDiaryEvent{
+			Type:            "C",
+			CourseName:      class.Course.Name,
+			CourseCode:      class.Course.Code,
+			Faculty:         class.Faculty,
+			Room:            class.Room,
+			Start:           class.StartTime.Format(diaryEventTimeFormat),
+			End:             class.EndTime.Format(diaryEventTimeFormat),
+			AttendanceColor: attendanceColors[class.Attended],
+		})
+	}
+	return json.Marshal(events)
+}
@@ -0,0 +1,186 @@
+package amizonetest
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+// Scenario describes the behaviour of a fake Amizone started with NewServer. Scenarios are built by chaining
+// methods on NewScenario; pages the scenario doesn't customise are served from the fixtures, like by Portal.
+type Scenario struct {
+	users           []PortalUser
+	attendance      models.AttendanceRecords
+	examSchedule    *models.ExaminationSchedule
+	classSchedule   models.ClassSchedule
+	sessionRequests int
+	faults          []*fault
+}
+
+// fault is an error or latency injected into the responses for requests to a path.
+type fault struct {
+	path    string
+	status  int
+	latency time.Duration
+
+	mu sync.Mutex
+	// remaining is the number of requests the fault applies to, with a negative value meaning all requests.
+	remaining int
+}
+
+// NewScenario returns a Scenario serving the fixtures for a single user with DefaultUsername and DefaultPassword as
+// credentials.
+func NewScenario() *Scenario {
+	return &Scenario{}
+}
+
+// AttendanceRecord returns an attendance record for a course, as a shorthand for building scenarios.
+func AttendanceRecord(code, name string, attended, held int32) models.AttendanceRecord {
+	return models.AttendanceRecord{
+		Course:     models.CourseRef{Code: code, Name: name},
+		Attendance: models.Attendance{ClassesAttended: attended, ClassesHeld: held},
+	}
+}
+
+// WithUsers replaces the users of the scenario.
+func (s *Scenario) WithUsers(users ...PortalUser) *Scenario {
+	s.users = users
+	return s
+}
+
+// WithAttendance makes the home page show records in place of the attendance in the fixture.
+func (s *Scenario) WithAttendance(records ...models.AttendanceRecord) *Scenario {
+	s.attendance = append(models.AttendanceRecords{}, records...)
+	return s
+}
+
+// WithExamSchedule makes the examination schedule page show schedule.
+func (s *Scenario) WithExamSchedule(schedule models.ExaminationSchedule) *Scenario {
+	s.examSchedule = &schedule
+	return s
+}
+
+// WithClassSchedule makes the diary serve the classes of schedule, in place of repeating the classes in the
+// fixture every day.
+func (s *Scenario) WithClassSchedule(schedule models.ClassSchedule) *Scenario {
+	s.classSchedule = schedule
+	if s.classSchedule == nil {
+		s.classSchedule = models.ClassSchedule{}
+	}
+	return s
+}
+
+// WithExpiringSessions makes sessions expire after requests authenticated requests, so clients have to log in
+// again. The request to the home page logins are redirected to counts towards the limit, so it should be at
+// least 2 for clients to get anything done.
+func (s *Scenario) WithExpiringSessions(requests int) *Scenario {
+	s.sessionRequests = requests
+	return s
+}
+
+// WithErrors makes the next times requests to paths starting with path fail with status. If times is negative,
+// all requests fail. Note that logins are redirected to the home page, so errors for "/Home" fail logins too.
+func (s *Scenario) WithErrors(path string, status int, times int) *Scenario {
+	s.faults = append(s.faults, &fault{path: path, status: status, remaining: times})
+	return s
+}
+
+// WithLatency delays the responses to all requests to paths starting with path by latency, or until the
+// request is cancelled. An empty path delays responses to all requests.
+func (s *Scenario) WithLatency(path string, latency time.Duration) *Scenario {
+	s.faults = append(s.faults, &fault{path: path, latency: latency, remaining: -1})
+	return s
+}
+
+// newPortal returns a Portal customised for the scenario.
+func (s *Scenario) newPortal() (*Portal, error) {
+	portal, err := NewPortal(s.users...)
+	if err != nil {
+		return nil, err
+	}
+	portal.sessionRequestLimit = s.sessionRequests
+
+	if s.attendance != nil {
+		page, err := renderHomePage(s.attendance)
+		if err != nil {
+			return nil, err
+		}
+		portal.routes[portalRoute{http.MethodGet, homeEndpoint}] = serveBytes(page, "text/html; charset=utf-8")
+	}
+	if s.examSchedule != nil {
+		page, err := renderExamSchedule(*s.examSchedule)
+		if err != nil {
+			return nil, err
+		}
+		portal.routes[portalRoute{http.MethodGet, examScheduleEndpoint}] = serveBytes(page, "text/html; charset=utf-8")
+	}
+	if s.classSchedule != nil {
+		schedule := s.classSchedule
+		portal.routes[portalRoute{http.MethodGet, diaryEventsEndpoint}] = func(w http.ResponseWriter, r *http.Request, _ string) {
+			start, end, err := diaryEventsRange(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			events, err := renderDiaryEvents(schedule, start, end)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			serveBytes(events, "application/json; charset=utf-8")(w, r, "")
+		}
+	}
+	return portal, nil
+}
+
+// handler wraps next with the faults of the scenario. Faults are counted per handler, so scenarios can be
+// reused.
+func (s *Scenario) handler(next http.Handler) http.Handler {
+	faults := make([]*fault, len(s.faults))
+	for i, f := range s.faults {
+		faults[i] = &fault{path: f.path, status: f.status, latency: f.latency, remaining: f.remaining}
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, f := range faults {
+			if !strings.HasPrefix(r.URL.Path, f.path) || !f.take() {
+				continue
+			}
+			if f.latency > 0 {
+				select {
+				case <-time.After(f.latency):
+				case <-r.Context().Done():
+					return
+				}
+			}
+			if f.status != 0 {
+				http.Error(w, http.StatusText(f.status), f.status)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// take reports whether the fault applies to a request, counting the request against it.
+func (f *fault) take() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.remaining == 0 {
+		return false
+	}
+	if f.remaining > 0 {
+		f.remaining--
+	}
+	return true
+}
+
+// serveBytes returns a handler serving body with contentType.
+func serveBytes(body []byte, contentType string) portalHandler {
+	return func(w http.ResponseWriter, _ *http.Request, _ string) {
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write(body)
+	}
+}
@@ -0,0 +1,128 @@
+package amizonetest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+func TestScenario(t *testing.T) {
+	cred := amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword}
+
+	t.Run("custom attendance", func(t *testing.T) {
+		g := NewWithT(t)
+		server := amizonetest.NewServer(t, amizonetest.NewScenario().WithAttendance(
+			amizonetest.AttendanceRecord("CSE101", "Programming & Problem Solving", 30, 40),
+			amizonetest.AttendanceRecord("MATH101", "Calculus", 0, 0),
+		))
+		client, err := server.NewAmizoneClient(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		attendance, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(attendance).To(Equal(models.AttendanceRecords{
+			amizonetest.AttendanceRecord("CSE101", "Programming & Problem Solving", 30, 40),
+			amizonetest.AttendanceRecord("MATH101", "Calculus", 0, 0),
+		}))
+	})
+
+	t.Run("custom exam schedule", func(t *testing.T) {
+		g := NewWithT(t)
+		schedule := models.ExaminationSchedule{
+			Title: "End Semester Examination",
+			Exams: []models.ScheduledExam{
+				{
+					Course: models.CourseRef{Code: "CSE101", Name: "Programming"},
+					Time:   time.Date(2023, time.May, 11, 10, 0, 0, 0, time.UTC),
+					Mode:   "MCQ",
+				},
+				{
+					Course:   models.CourseRef{Code: "MATH101", Name: "Calculus"},
+					Time:     time.Date(2023, time.May, 13, 14, 30, 0, 0, time.UTC),
+					Mode:     "Regular",
+					Location: "Block E3, Room 211",
+				},
+			},
+		}
+		server := amizonetest.NewServer(t, amizonetest.NewScenario().WithExamSchedule(schedule))
+		client, err := server.NewAmizoneClient(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		parsed, err := client.GetExamSchedule()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(*parsed).To(Equal(schedule))
+	})
+
+	t.Run("custom class schedule", func(t *testing.T) {
+		g := NewWithT(t)
+		class := models.ScheduledClass{
+			Course:    models.CourseRef{Code: "CSE101", Name: "Programming"},
+			StartTime: time.Date(2023, time.March, 6, 9, 15, 0, 0, time.UTC),
+			EndTime:   time.Date(2023, time.March, 6, 10, 10, 0, 0, time.UTC),
+			Faculty:   "Dr Someone",
+			Room:      "E3-211",
+			Attended:  models.AttendanceStatePresent,
+		}
+		later := class
+		later.StartTime, later.EndTime = class.StartTime.AddDate(0, 0, 1), class.EndTime.AddDate(0, 0, 1)
+		server := amizonetest.NewServer(t, amizonetest.NewScenario().WithClassSchedule(models.ClassSchedule{class, later}))
+		client, err := server.NewAmizoneClient(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		schedule, err := client.GetClassSchedule(2023, time.March, 6)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(schedule).To(Equal(models.ClassSchedule{class}))
+
+		schedule, err = client.GetClassSchedule(2023, time.March, 8)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(schedule).To(BeEmpty())
+	})
+
+	t.Run("expiring sessions", func(t *testing.T) {
+		g := NewWithT(t)
+		server := amizonetest.NewServer(t, amizonetest.NewScenario().WithExpiringSessions(2))
+		client, err := server.NewAmizoneClient(cred, amizone.WithLoginCooldown(0))
+		g.Expect(err).ToNot(HaveOccurred())
+		before, err := client.ExportSession()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		for i := 0; i < 3; i++ {
+			_, err = client.GetAttendance()
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+		after, err := client.ExportSession()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(after).ToNot(Equal(before), "the client should have logged in again")
+	})
+
+	t.Run("server errors", func(t *testing.T) {
+		g := NewWithT(t)
+		server := amizonetest.NewServer(t, amizonetest.NewScenario().WithErrors("/IDCard", http.StatusInternalServerError, 1))
+		client, err := server.NewAmizoneClient(cred, amizone.WithRetryPolicy(amizone.RetryPolicy{}))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = client.GetUserProfile()
+		g.Expect(amizone.IsUnavailable(err)).To(BeTrue())
+		_, err = client.GetUserProfile()
+		g.Expect(err).ToNot(HaveOccurred(), "only the first request should fail")
+	})
+
+	t.Run("slow responses", func(t *testing.T) {
+		g := NewWithT(t)
+		server := amizonetest.NewServer(t, amizonetest.NewScenario().WithLatency("/IDCard", time.Second))
+		client, err := server.NewAmizoneClient(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = client.GetUserProfileWithContext(ctx)
+		g.Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+}
@@ -0,0 +1,50 @@
+package amizonetest
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+
+	"github.com/ditsuke/go-amizone/amizone"
+)
+
+// Server is a fake Amizone served by an httptest.Server, behaving as described by a Scenario.
+type Server struct {
+	*httptest.Server
+	// Portal is the portal behind the server, for inspecting and changing its state.
+	Portal *Portal
+}
+
+// NewServer starts a Server for scenario, which is closed when the test completes. A nil scenario serves the
+// fixtures, like NewScenario().
+func NewServer(t testing.TB, scenario *Scenario) *Server {
+	t.Helper()
+	if scenario == nil {
+		scenario = NewScenario()
+	}
+	portal, err := scenario.newPortal()
+	if err != nil {
+		t.Fatalf("amizonetest: failed to set up the portal: %s", err.Error())
+	}
+	server := &Server{
+		Server: httptest.NewServer(scenario.handler(portal)),
+		Portal: portal,
+	}
+	t.Cleanup(server.Close)
+	return server
+}
+
+// NewAmizoneClient returns an amizone.Client for cred that talks to the server. The client has its own cookie
+// jar and discards logs unless opts say otherwise.
+func (s *Server) NewAmizoneClient(cred amizone.Credentials, opts ...amizone.ClientOption) (*amizone.Client, error) {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := &http.Client{Jar: jar, Transport: s.Client().Transport}
+	opts = append([]amizone.ClientOption{amizone.WithBaseURL(s.URL), amizone.WithLogger(logr.Discard())}, opts...)
+	return amizone.NewClient(cred, httpClient, opts...)
+}
@@ -0,0 +1,74 @@
+package amizone_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+)
+
+// The benchmarks in this file run the client against a fake Amizone served locally, so they measure the whole
+// request path (composing requests, the round trip, checking the session and parsing) rather than the network.
+
+// clientBenchmarks are the benchmarks of BenchmarkClient: each calls a read-only method of a logged-in client.
+var clientBenchmarks = []struct {
+	name string
+	call func(client *amizone.Client) error
+}{
+	{"GetAttendance", func(c *amizone.Client) error { _, err := c.GetAttendance(); return err }},
+	{"GetClassSchedule", func(c *amizone.Client) error { _, err := c.GetClassSchedule(2022, time.September, 5); return err }},
+	{"GetExamSchedule", func(c *amizone.Client) error { _, err := c.GetExamSchedule(); return err }},
+	{"GetSemesters", func(c *amizone.Client) error { _, err := c.GetSemesters(); return err }},
+	{"GetCurrentCourses", func(c *amizone.Client) error { _, err := c.GetCurrentCourses(); return err }},
+	{"GetCourses", func(c *amizone.Client) error { _, err := c.GetCourses("1"); return err }},
+	{"GetCurrentExaminationResult", func(c *amizone.Client) error { _, err := c.GetCurrentExaminationResult(); return err }},
+	{"GetExaminationResult", func(c *amizone.Client) error { _, err := c.GetExaminationResult("1"); return err }},
+	{"GetUserProfile", func(c *amizone.Client) error { _, err := c.GetUserProfile(); return err }},
+	{"GetWiFiMacInformation", func(c *amizone.Client) error { _, err := c.GetWiFiMacInformation(); return err }},
+}
+
+func BenchmarkClient(b *testing.B) {
+	server := amizonetest.NewServer(b, nil)
+	client, err := server.NewAmizoneClient(defaultCredentials)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, bm := range clientBenchmarks {
+		bm := bm
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := bm.call(client); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkClient_HealthMonitor measures the overhead of checking every page with a HealthMonitor. The API
+// server only checks a sample of the pages it fetches.
+func BenchmarkClient_HealthMonitor(b *testing.B) {
+	server := amizonetest.NewServer(b, nil)
+	client, err := server.NewAmizoneClient(defaultCredentials, amizone.WithHealthMonitor(amizone.NewHealthMonitor(1)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.GetAttendance(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNewClient(b *testing.B) {
+	server := amizonetest.NewServer(b, nil)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := server.NewAmizoneClient(defaultCredentials); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,380 @@
+package amizone
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+// CachedMethod names a method of the Client whose results can be cached by CachingClient.
+type CachedMethod string
+
+// Methods whose results can be cached. Methods taking parameters are cached per set of parameters.
+const (
+	CacheAttendance         CachedMethod = "GetAttendance"
+	CacheCurrentExamResult  CachedMethod = "GetCurrentExaminationResult"
+	CacheExamResult         CachedMethod = "GetExaminationResult"
+	CacheClassSchedule      CachedMethod = "GetClassSchedule"
+	CacheExamSchedule       CachedMethod = "GetExamSchedule"
+	CacheSemesters          CachedMethod = "GetSemesters"
+	CacheCourses            CachedMethod = "GetCourses"
+	CacheCurrentCourses     CachedMethod = "GetCurrentCourses"
+	CacheUserProfile        CachedMethod = "GetUserProfile"
+	CacheWiFiMacInformation CachedMethod = "GetWiFiMacInformation"
+)
+
+// DefaultCacheStaleTTL is how long NewCache keeps expired results by default, to be served while Amizone is down.
+const DefaultCacheStaleTTL = 24 * time.Hour
+
+const (
+	cachePruneInterval = time.Minute
+	cacheKeySeparator  = "\x00"
+)
+
+// CacheTTLs maps methods to how long their results are cached for. Methods missing from the map aren't cached.
+type CacheTTLs map[CachedMethod]time.Duration
+
+// DefaultCacheTTLs are the TTLs used by NewCache when none are passed. Data that rarely changes, like the
+// profile or the results of past semesters, is cached for long; attendance and the like only briefly.
+var DefaultCacheTTLs = CacheTTLs{
+	CacheAttendance:         10 * time.Minute,
+	CacheCurrentExamResult:  time.Hour,
+	CacheExamResult:         6 * time.Hour,
+	CacheClassSchedule:      30 * time.Minute,
+	CacheExamSchedule:       time.Hour,
+	CacheSemesters:          12 * time.Hour,
+	CacheCourses:            6 * time.Hour,
+	CacheCurrentCourses:     15 * time.Minute,
+	CacheUserProfile:        24 * time.Hour,
+	CacheWiFiMacInformation: 5 * time.Minute,
+}
+
+// Cache holds results for CachingClient. Results of a Client are keyed by its user and the Amizone deployment it
+// talks to, so a Cache can be shared by clients for different users, for example by all the clients of a server.
+// A Cache is safe for concurrent use.
+type Cache struct {
+	ttls     CacheTTLs
+	staleTTL time.Duration
+
+	mu        sync.Mutex
+	entries   map[string]cacheEntry
+	lastPrune time.Time
+}
+
+type cacheEntry struct {
+	value   any
+	fetched time.Time
+	ttl     time.Duration
+}
+
+// NewCache returns a Cache caching results for the durations in ttls, or DefaultCacheTTLs if ttls is nil.
+// Once expired, results are kept for a further staleTTL, to be served in place of fresh results while Amizone
+// is unavailable. A negative staleTTL disables serving stale results; zero means DefaultCacheStaleTTL.
+func NewCache(ttls CacheTTLs, staleTTL time.Duration) *Cache {
+	if ttls == nil {
+		ttls = DefaultCacheTTLs
+	}
+	if staleTTL == 0 {
+		staleTTL = DefaultCacheStaleTTL
+	}
+	if staleTTL < 0 {
+		staleTTL = 0
+	}
+	return &Cache{
+		ttls:     ttls,
+		staleTTL: staleTTL,
+		entries:  make(map[string]cacheEntry),
+	}
+}
+
+// Len returns the number of results held by the cache, including expired results kept to be served stale.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Purge removes all results from the cache.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]cacheEntry)
+}
+
+// get returns the result cached for key, and whether it's still fresh.
+func (c *Cache) get(key string) (value any, fresh bool, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false, false
+	}
+	age := time.Since(entry.fetched)
+	if age >= entry.ttl+c.staleTTL {
+		delete(c.entries, key)
+		return nil, false, false
+	}
+	return entry.value, age < entry.ttl, true
+}
+
+func (c *Cache) set(key string, value any, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.entries[key] = cacheEntry{value: value, fetched: now, ttl: ttl}
+	if now.Sub(c.lastPrune) < cachePruneInterval {
+		return
+	}
+	c.lastPrune = now
+	for k, entry := range c.entries {
+		if now.Sub(entry.fetched) >= entry.ttl+c.staleTTL {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// deletePrefix removes all results with keys starting with prefix.
+func (c *Cache) deletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// CachingClient decorates a ClientInterface, caching the results of its getters in a Cache. Expired results are
+// served in place of fresh ones while Amizone is unavailable, as determined by IsUnavailable, and results affected
+// by mutations made through the CachingClient, like RegisterWifiMac, are invalidated.
+// Results are shared between callers, so they must not be modified.
+type CachingClient struct {
+	client    ClientInterface
+	cache     *Cache
+	keyPrefix string
+	logger    logr.Logger
+}
+
+// Interface compliance constraint for CachingClient
+var _ ClientInterface = &CachingClient{}
+
+// cachingClientIDs numbers the CachingClients decorating clients other than Client, whose results can't be
+// told apart from those of other clients otherwise.
+var cachingClientIDs int64
+
+// circuitStater is implemented by clients with a CircuitBreaker, like Client.
+type circuitStater interface {
+	CircuitState() CircuitState
+}
+
+// NewCachingClient returns a CachingClient caching the results of client in cache. Results of a Client are shared
+// with the other clients for its user and Amizone deployment that cache in cache. Other implementations of
+// ClientInterface, like mocks, can't be told apart, so their results are only served by the CachingClient that
+// cached them.
+func NewCachingClient(client ClientInterface, cache *Cache) *CachingClient {
+	c := &CachingClient{client: client, cache: cache, logger: logr.Discard()}
+	if client, ok := client.(*Client); ok {
+		c.keyPrefix = client.baseURL + cacheKeySeparator + client.credentials.Username + cacheKeySeparator
+		c.logger = client.logger
+	} else {
+		c.keyPrefix = "#" + strconv.FormatInt(atomic.AddInt64(&cachingClientIDs, 1), 10) + cacheKeySeparator
+	}
+	return c
+}
+
+// Invalidate removes the results of methods cached for the client's user, or all their results if no methods
+// are passed.
+func (c *CachingClient) Invalidate(methods ...CachedMethod) {
+	if len(methods) == 0 {
+		c.cache.deletePrefix(c.keyPrefix)
+		return
+	}
+	for _, method := range methods {
+		c.cache.deletePrefix(c.keyPrefix + string(method) + cacheKeySeparator)
+	}
+}
+
+// cached returns the result of fetch for method and its parameters, as cached or freshly fetched.
+func cached[T any](ctx context.Context, c *CachingClient, method CachedMethod, params string, fetch func(context.Context) (T, error)) (T, error) {
+	ttl, cacheable := c.cache.ttls[method]
+	if !cacheable || ttl <= 0 {
+		return fetch(ctx)
+	}
+
+	key := c.keyPrefix + string(method) + cacheKeySeparator + params
+	value, fresh, ok := c.cache.get(key)
+	if ok && fresh {
+		return value.(T), nil
+	}
+	// Don't bother with Amizone while it's known to be down if we have something to serve.
+	if ok && c.CircuitState() == CircuitOpen {
+		return value.(T), nil
+	}
+
+	result, err := fetch(ctx)
+	if err != nil {
+		if ok && IsUnavailable(err) {
+			c.logger.V(1).Info("Serving stale result while Amizone is unavailable", "method", string(method), "error", err.Error())
+			return value.(T), nil
+		}
+		return result, err
+	}
+	c.cache.set(key, result, ttl)
+	return result, nil
+}
+
+func (c *CachingClient) DidLogin() bool {
+	return c.client.DidLogin()
+}
+
+// CircuitState returns the state of the underlying client's CircuitBreaker, or CircuitClosed if it has none.
+func (c *CachingClient) CircuitState() CircuitState {
+	if client, ok := c.client.(circuitStater); ok {
+		return client.CircuitState()
+	}
+	return CircuitClosed
+}
+
+func (c *CachingClient) GetAttendance() (models.AttendanceRecords, error) {
+	return c.GetAttendanceWithContext(context.Background())
+}
+
+func (c *CachingClient) GetAttendanceWithContext(ctx context.Context) (models.AttendanceRecords, error) {
+	return cached(ctx, c, CacheAttendance, "", c.client.GetAttendanceWithContext)
+}
+
+func (c *CachingClient) GetCurrentExaminationResult() (*models.ExamResultRecords, error) {
+	return c.GetCurrentExaminationResultWithContext(context.Background())
+}
+
+func (c *CachingClient) GetCurrentExaminationResultWithContext(ctx context.Context) (*models.ExamResultRecords, error) {
+	return cached(ctx, c, CacheCurrentExamResult, "", c.client.GetCurrentExaminationResultWithContext)
+}
+
+func (c *CachingClient) GetExaminationResult(semesterRef string) (*models.ExamResultRecords, error) {
+	return c.GetExaminationResultWithContext(context.Background(), semesterRef)
+}
+
+func (c *CachingClient) GetExaminationResultWithContext(ctx context.Context, semesterRef string) (*models.ExamResultRecords, error) {
+	return cached(ctx, c, CacheExamResult, semesterRef, func(ctx context.Context) (*models.ExamResultRecords, error) {
+		return c.client.GetExaminationResultWithContext(ctx, semesterRef)
+	})
+}
+
+func (c *CachingClient) GetClassSchedule(year int, month time.Month, date int) (models.ClassSchedule, error) {
+	return c.GetClassScheduleWithContext(context.Background(), year, month, date)
+}
+
+func (c *CachingClient) GetClassScheduleWithContext(ctx context.Context, year int, month time.Month, date int) (models.ClassSchedule, error) {
+	params := fmt.Sprintf("%04d-%02d-%02d", year, month, date)
+	return cached(ctx, c, CacheClassSchedule, params, func(ctx context.Context) (models.ClassSchedule, error) {
+		return c.client.GetClassScheduleWithContext(ctx, year, month, date)
+	})
+}
+
+func (c *CachingClient) GetClassScheduleRange(from, to time.Time) (models.ClassSchedule, error) {
+	return c.GetClassScheduleRangeWithContext(context.Background(), from, to)
+}
+
+// GetClassScheduleRangeWithContext caches ranges apart from single days, with the TTL of CacheClassSchedule.
+// Ranges are keyed by the dates the client fetches, normalised the same way.
+func (c *CachingClient) GetClassScheduleRangeWithContext(ctx context.Context, from, to time.Time) (models.ClassSchedule, error) {
+	timeFrom, timeTo, err := classScheduleRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	params := timeFrom.Format("2006-01-02") + "/" + timeTo.Format("2006-01-02")
+	return cached(ctx, c, CacheClassSchedule, params, func(ctx context.Context) (models.ClassSchedule, error) {
+		return c.client.GetClassScheduleRangeWithContext(ctx, from, to)
+	})
+}
+
+func (c *CachingClient) GetExamSchedule() (*models.ExaminationSchedule, error) {
+	return c.GetExamScheduleWithContext(context.Background())
+}
+
+func (c *CachingClient) GetExamScheduleWithContext(ctx context.Context) (*models.ExaminationSchedule, error) {
+	return cached(ctx, c, CacheExamSchedule, "", c.client.GetExamScheduleWithContext)
+}
+
+func (c *CachingClient) GetSemesters() (models.SemesterList, error) {
+	return c.GetSemestersWithContext(context.Background())
+}
+
+func (c *CachingClient) GetSemestersWithContext(ctx context.Context) (models.SemesterList, error) {
+	return cached(ctx, c, CacheSemesters, "", c.client.GetSemestersWithContext)
+}
+
+func (c *CachingClient) GetCourses(semesterRef string) (models.Courses, error) {
+	return c.GetCoursesWithContext(context.Background(), semesterRef)
+}
+
+func (c *CachingClient) GetCoursesWithContext(ctx context.Context, semesterRef string) (models.Courses, error) {
+	return cached(ctx, c, CacheCourses, semesterRef, func(ctx context.Context) (models.Courses, error) {
+		return c.client.GetCoursesWithContext(ctx, semesterRef)
+	})
+}
+
+func (c *CachingClient) GetCurrentCourses() (models.Courses, error) {
+	return c.GetCurrentCoursesWithContext(context.Background())
+}
+
+func (c *CachingClient) GetCurrentCoursesWithContext(ctx context.Context) (models.Courses, error) {
+	return cached(ctx, c, CacheCurrentCourses, "", c.client.GetCurrentCoursesWithContext)
+}
+
+func (c *CachingClient) GetUserProfile() (*models.Profile, error) {
+	return c.GetUserProfileWithContext(context.Background())
+}
+
+func (c *CachingClient) GetUserProfileWithContext(ctx context.Context) (*models.Profile, error) {
+	return cached(ctx, c, CacheUserProfile, "", c.client.GetUserProfileWithContext)
+}
+
+func (c *CachingClient) GetWiFiMacInformation() (*models.WifiMacInfo, error) {
+	return c.GetWiFiMacInformationWithContext(context.Background())
+}
+
+func (c *CachingClient) GetWiFiMacInformationWithContext(ctx context.Context) (*models.WifiMacInfo, error) {
+	return cached(ctx, c, CacheWiFiMacInformation, "", c.client.GetWiFiMacInformationWithContext)
+}
+
+// RegisterWifiMac registers addr through the underlying client, invalidating the cached wifi information.
+func (c *CachingClient) RegisterWifiMac(addr net.HardwareAddr, bypassLimit bool) error {
+	return c.RegisterWifiMacWithContext(context.Background(), addr, bypassLimit)
+}
+
+func (c *CachingClient) RegisterWifiMacWithContext(ctx context.Context, addr net.HardwareAddr, bypassLimit bool) error {
+	// Invalidate regardless of the outcome: a failed attempt might have gone through on Amizone's end.
+	defer c.Invalidate(CacheWiFiMacInformation)
+	return c.client.RegisterWifiMacWithContext(ctx, addr, bypassLimit)
+}
+
+// RemoveWifiMac removes addr through the underlying client, invalidating the cached wifi information.
+func (c *CachingClient) RemoveWifiMac(addr net.HardwareAddr) error {
+	return c.RemoveWifiMacWithContext(context.Background(), addr)
+}
+
+func (c *CachingClient) RemoveWifiMacWithContext(ctx context.Context, addr net.HardwareAddr) error {
+	defer c.Invalidate(CacheWiFiMacInformation)
+	return c.client.RemoveWifiMacWithContext(ctx, addr)
+}
+
+func (c *CachingClient) SubmitFacultyFeedbackHack(rating int32, queryRating int32, comment string) (int32, error) {
+	return c.SubmitFacultyFeedbackHackWithContext(context.Background(), rating, queryRating, comment)
+}
+
+func (c *CachingClient) SubmitFacultyFeedbackHackWithContext(ctx context.Context, rating int32, queryRating int32, comment string) (int32, error) {
+	return c.client.SubmitFacultyFeedbackHackWithContext(ctx, rating, queryRating, comment)
+}
@@ -0,0 +1,205 @@
+package amizone_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/internal/mock"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+func TestCachingClient(t *testing.T) {
+	setupNetworking()
+	t.Cleanup(teardown)
+
+	t.Run("results are cached", func(t *testing.T) {
+		g := NewWithT(t)
+		cache := amizone.NewCache(nil, 0)
+		client := amizone.NewCachingClient(newLoggedInClientWithOptions(g), cache)
+
+		g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+		first, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(gock.IsDone()).To(BeTrue())
+
+		// No routes are registered, so the result must come from the cache.
+		second, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(second).To(Equal(first))
+		g.Expect(cache.Len()).To(Equal(1))
+	})
+
+	t.Run("results are cached per parameter", func(t *testing.T) {
+		g := NewWithT(t)
+		client := amizone.NewCachingClient(newLoggedInClientWithOptions(g), amizone.NewCache(nil, 0))
+
+		g.Expect(mock.GockRegisterSemesterCoursesRequest("1")).ToNot(HaveOccurred())
+		_, err := client.GetCourses("1")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(mock.GockRegisterSemesterCoursesRequest("2")).ToNot(HaveOccurred())
+		_, err = client.GetCourses("2")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(gock.IsDone()).To(BeTrue(), "courses for another semester should not be served from the cache")
+
+		_, err = client.GetCourses("1")
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+
+	t.Run("methods without a TTL aren't cached", func(t *testing.T) {
+		g := NewWithT(t)
+		client := amizone.NewCachingClient(newLoggedInClientWithOptions(g), amizone.NewCache(amizone.CacheTTLs{}, 0))
+
+		g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+		_, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = client.GetAttendance()
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("stale results are served while Amizone is unavailable", func(t *testing.T) {
+		g := NewWithT(t)
+		ttls := amizone.CacheTTLs{amizone.CacheAttendance: time.Millisecond}
+		client := amizone.NewCachingClient(newLoggedInClientWithOptions(g), amizone.NewCache(ttls, time.Hour))
+
+		g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+		fresh, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		time.Sleep(5 * time.Millisecond)
+
+		gock.New(mock.BaseUrl).Get("/Home").Reply(http.StatusServiceUnavailable)
+		stale, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(stale).To(Equal(fresh))
+		g.Expect(gock.IsDone()).To(BeTrue(), "expired results should be revalidated")
+
+		gock.New(mock.BaseUrl).Get("/Home").Reply(http.StatusNotFound)
+		_, err = client.GetAttendance()
+		g.Expect(err).To(MatchError(amizone.ErrNon200StatusCode), "stale results shouldn't mask other failures")
+	})
+
+	t.Run("stale results are served while the circuit is open", func(t *testing.T) {
+		g := NewWithT(t)
+		breaker := amizone.NewCircuitBreaker(1, time.Hour)
+		ttls := amizone.CacheTTLs{amizone.CacheAttendance: time.Millisecond}
+		client := amizone.NewCachingClient(newLoggedInClientWithOptions(g, amizone.WithCircuitBreaker(breaker)), amizone.NewCache(ttls, time.Hour))
+
+		g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+		_, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		time.Sleep(5 * time.Millisecond)
+
+		gock.New(mock.BaseUrl).Get("/Home").Reply(http.StatusBadGateway)
+		_, err = client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(client.CircuitState()).To(Equal(amizone.CircuitOpen))
+
+		_, err = client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = client.GetSemesters()
+		g.Expect(err).To(MatchError(amizone.ErrCircuitOpen), "uncached results can't be served")
+	})
+
+	t.Run("mutations invalidate affected results", func(t *testing.T) {
+		g := NewWithT(t)
+		client := amizone.NewCachingClient(newLoggedInClientWithOptions(g), amizone.NewCache(nil, 0))
+
+		g.Expect(mock.GockRegisterWifiInfo()).ToNot(HaveOccurred())
+		g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+		_, err := client.GetWiFiMacInformation()
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		// The removal fails as no routes are registered, but might have gone through regardless.
+		addr, err := net.ParseMAC("00:11:22:33:44:55")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(client.RemoveWifiMac(addr)).ToNot(Succeed())
+
+		g.Expect(mock.GockRegisterWifiInfo()).ToNot(HaveOccurred())
+		_, err = client.GetWiFiMacInformation()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(gock.IsDone()).To(BeTrue(), "wifi information should be refetched")
+
+		_, err = client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred(), "unaffected results should still be cached")
+
+		client.Invalidate()
+		_, err = client.GetAttendance()
+		g.Expect(err).To(HaveOccurred(), "all results should be invalidated")
+	})
+	t.Run("other clients are decorated", func(t *testing.T) {
+		g := NewWithT(t)
+		cache := amizone.NewCache(nil, 0)
+		mockClient := &amizonetest.MockClient{
+			GetSemestersFunc: func(context.Context) (models.SemesterList, error) {
+				return models.SemesterList{{Name: "Semester 1", Ref: "1"}}, nil
+			},
+		}
+		client := amizone.NewCachingClient(mockClient, cache)
+		for i := 0; i < 2; i++ {
+			semesters, err := client.GetSemesters()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(semesters).To(HaveLen(1))
+		}
+		g.Expect(mockClient.CallCount("GetSemesters")).To(Equal(1))
+		g.Expect(client.CircuitState()).To(Equal(amizone.CircuitClosed))
+
+		// Mocks can't be told apart, so results aren't shared between the clients decorating them.
+		_, err := amizone.NewCachingClient(mockClient, cache).GetSemesters()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(mockClient.CallCount("GetSemesters")).To(Equal(2))
+	})
+
+	t.Run("ranges are cached by the dates fetched", func(t *testing.T) {
+		g := NewWithT(t)
+		mockClient := &amizonetest.MockClient{
+			GetClassScheduleRangeFunc: func(context.Context, time.Time, time.Time) (models.ClassSchedule, error) {
+				return models.ClassSchedule{}, nil
+			},
+		}
+		client := amizone.NewCachingClient(mockClient, amizone.NewCache(nil, 0))
+		ist := time.FixedZone("IST", 5*60*60+30*60)
+
+		_, err := client.GetClassScheduleRange(time.Date(2022, 9, 5, 0, 0, 0, 0, time.UTC), time.Date(2022, 9, 11, 0, 0, 0, 0, time.UTC))
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = client.GetClassScheduleRange(time.Date(2022, 9, 5, 9, 15, 0, 0, ist), time.Date(2022, 9, 11, 23, 0, 0, 0, ist))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(mockClient.CallCount("GetClassScheduleRange")).To(Equal(1), "the same dates should be served from the cache")
+
+		_, err = client.GetClassScheduleRange(time.Date(2022, 9, 5, 0, 0, 0, 0, time.UTC), time.Date(2022, 9, 12, 0, 0, 0, 0, time.UTC))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(mockClient.CallCount("GetClassScheduleRange")).To(Equal(2))
+
+		_, err = client.GetClassScheduleRange(time.Date(2022, 9, 11, 0, 0, 0, 0, time.UTC), time.Date(2022, 9, 5, 0, 0, 0, 0, time.UTC))
+		g.Expect(err).To(MatchError(amizone.ErrInvalidDateRange))
+		g.Expect(mockClient.CallCount("GetClassScheduleRange")).To(Equal(2))
+	})
+
+	t.Run("results are kept apart per deployment", func(t *testing.T) {
+		g := NewWithT(t)
+		cache := amizone.NewCache(nil, 0)
+		first := amizonetest.NewServer(t, nil)
+		second := amizonetest.NewServer(t, amizonetest.NewScenario().WithAttendance(amizonetest.AttendanceRecord("CSE101", "Programming", 1, 2)))
+		cred := amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword}
+
+		firstClient, err := first.NewAmizoneClient(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+		secondClient, err := second.NewAmizoneClient(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+		firstAttendance, err := amizone.NewCachingClient(firstClient, cache).GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		secondAttendance, err := amizone.NewCachingClient(secondClient, cache).GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(secondAttendance).To(HaveLen(1))
+		g.Expect(secondAttendance).ToNot(Equal(firstAttendance), "the same user on another deployment shouldn't share results")
+	})
+}
@@ -0,0 +1,189 @@
+package cassette
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ditsuke/go-amizone/amizone/internal/mock"
+	"github.com/ditsuke/go-amizone/amizone/internal/parse"
+)
+
+// Identity is the personal information of a user to scrub from recordings, beyond what Anonymiser finds by
+// pattern. Empty fields are ignored.
+type Identity struct {
+	// Username is the Amizone ID the user logs in with.
+	Username         string
+	Name             string
+	EnrollmentNumber string
+	IDCardNumber     string
+	UUID             string
+	DateOfBirth      time.Time
+}
+
+// dateFormats are the formats dates of birth are searched for in.
+var dateFormats = []string{"02.01.2006", "02/01/2006", "2006-01-02", "2006/01/02"}
+
+var (
+	uuidRegexp       = regexp.MustCompile(`\b\w{8}-\w{4}-\w{4}-\w{4}-\w{12}\b`)
+	enrollmentRegexp = regexp.MustCompile(`\bA\d{10}\b`)
+	macRegexp        = regexp.MustCompile(`\b[0-9A-Fa-f]{2}(?:[:-][0-9A-Fa-f]{2}){5}\b`)
+)
+
+// IdentityFromInteractions returns the identity of the user in a recording: the username from the login
+// request, and the rest from the ID card page, if the recording has them.
+func IdentityFromInteractions(interactions []Interaction) Identity {
+	var identity Identity
+	for _, interaction := range interactions {
+		if interaction.Request.Method == "POST" && interaction.Request.URL == "/" {
+			if form, err := url.ParseQuery(interaction.Request.Body); err == nil && form.Get("_UserName") != "" {
+				identity.Username = form.Get("_UserName")
+			}
+		}
+		if path, _, _ := strings.Cut(interaction.Request.URL, "?"); path != "/IDCard" {
+			continue
+		}
+		profile, err := parse.Profile(strings.NewReader(interaction.Response.Body))
+		if err != nil {
+			continue
+		}
+		identity.Name = profile.Name
+		identity.EnrollmentNumber = profile.EnrollmentNumber
+		identity.IDCardNumber = profile.IDCardNumber
+		identity.UUID = profile.UUID
+		identity.DateOfBirth = profile.DateOfBirth
+	}
+	return identity
+}
+
+// Anonymiser replaces personal information in recordings with the placeholders of the amizone package's
+// fixtures, so recordings can be contributed as fixtures: the fields of an Identity, and anything that looks
+// like a UUID, an enrollment number or a MAC address. MAC addresses are replaced consistently, so an address
+// maps to the same placeholder everywhere it appears.
+// Anonymisation is best-effort: recordings should still be reviewed before they're shared.
+type Anonymiser struct {
+	// rules are the replacements for the identity scrubbed, applied before the patterns.
+	rules []func(string) string
+	// macs maps normalised MAC addresses to their placeholders.
+	macs map[string]string
+}
+
+// NewAnonymiser returns an Anonymiser that scrubs identity, on top of the patterns it always scrubs.
+func NewAnonymiser(identity Identity) *Anonymiser {
+	a := &Anonymiser{macs: make(map[string]string)}
+	if identity.Name != "" {
+		nameRegexp := regexp.MustCompile(`(?i)` + strings.Join(strings.Fields(regexp.QuoteMeta(identity.Name)), `\s+`))
+		a.rules = append(a.rules, func(s string) string {
+			return nameRegexp.ReplaceAllStringFunc(s, func(match string) string {
+				if match == strings.ToUpper(match) {
+					return strings.ToUpper(mock.StudentName)
+				}
+				return mock.StudentName
+			})
+		})
+	}
+	a.addWord(identity.Username, mock.ValidUser)
+	a.addWord(identity.IDCardNumber, mock.StudentIDCardNumber)
+	a.addLiteral(identity.EnrollmentNumber, mock.StudentEnrollmentNumber)
+	a.addLiteral(identity.UUID, mock.StudentUUID)
+	if !identity.DateOfBirth.IsZero() {
+		for _, format := range dateFormats {
+			a.addLiteral(identity.DateOfBirth.Format(format), mock.StudentDOB.Time().Format(format))
+		}
+	}
+	return a
+}
+
+// addWord adds a rule replacing value with placeholder where it appears as a whole word.
+func (a *Anonymiser) addWord(value, placeholder string) {
+	if value == "" {
+		return
+	}
+	wordRegexp := regexp.MustCompile(`\b` + regexp.QuoteMeta(value) + `\b`)
+	a.rules = append(a.rules, func(s string) string {
+		return wordRegexp.ReplaceAllLiteralString(s, placeholder)
+	})
+}
+
+// addLiteral adds a rule replacing value with placeholder.
+func (a *Anonymiser) addLiteral(value, placeholder string) {
+	if value == "" {
+		return
+	}
+	a.rules = append(a.rules, func(s string) string {
+		return strings.ReplaceAll(s, value, placeholder)
+	})
+}
+
+// String anonymises s.
+func (a *Anonymiser) String(s string) string {
+	for _, rule := range a.rules {
+		s = rule(s)
+	}
+	s = uuidRegexp.ReplaceAllLiteralString(s, mock.StudentUUID)
+	s = enrollmentRegexp.ReplaceAllLiteralString(s, mock.StudentEnrollmentNumber)
+	s = macRegexp.ReplaceAllStringFunc(s, a.mac)
+	return s
+}
+
+// mac returns the placeholder for mac, assigning the next one if it hasn't been seen yet.
+func (a *Anonymiser) mac(mac string) string {
+	normalised := strings.ToLower(strings.ReplaceAll(mac, ":", "-"))
+	if placeholder, ok := a.macs[normalised]; ok {
+		return placeholder
+	}
+	var placeholder string
+	switch len(a.macs) {
+	case 0:
+		placeholder = mock.ValidMac1
+	case 1:
+		placeholder = mock.ValidMac2
+	default:
+		placeholder = fmt.Sprintf("02-00-00-00-%02x-%02x", len(a.macs)/256, len(a.macs)%256)
+	}
+	a.macs[normalised] = placeholder
+	return placeholder
+}
+
+// Interaction returns an anonymised copy of interaction.
+func (a *Anonymiser) Interaction(interaction Interaction) Interaction {
+	interaction.Request.URL = a.String(interaction.Request.URL)
+	interaction.Request.Body = a.String(interaction.Request.Body)
+	interaction.Response.Body = a.String(interaction.Response.Body)
+	if location := interaction.Response.Header.Get("Location"); location != "" {
+		interaction.Response.Header = interaction.Response.Header.Clone()
+		interaction.Response.Header.Set("Location", a.String(location))
+	}
+	return interaction
+}
+
+// AnonymiseCassette anonymises the cassette in dir into a new cassette in outDir. The identity scrubbed is
+// identity, with empty fields filled in from the recording with IdentityFromInteractions.
+func AnonymiseCassette(dir, outDir string, identity Identity) error {
+	interactions, err := Load(dir)
+	if err != nil {
+		return err
+	}
+	found := IdentityFromInteractions(interactions)
+	fill := func(field *string, value string) {
+		if *field == "" {
+			*field = value
+		}
+	}
+	fill(&identity.Username, found.Username)
+	fill(&identity.Name, found.Name)
+	fill(&identity.EnrollmentNumber, found.EnrollmentNumber)
+	fill(&identity.IDCardNumber, found.IDCardNumber)
+	fill(&identity.UUID, found.UUID)
+	if identity.DateOfBirth.IsZero() {
+		identity.DateOfBirth = found.DateOfBirth
+	}
+
+	anonymiser := NewAnonymiser(identity)
+	for i := range interactions {
+		interactions[i] = anonymiser.Interaction(interactions[i])
+	}
+	return Save(outDir, interactions)
+}
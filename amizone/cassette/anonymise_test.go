@@ -0,0 +1,90 @@
+package cassette_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone/cassette"
+)
+
+func TestAnonymiser(t *testing.T) {
+	identity := cassette.Identity{
+		Username:         "7654321",
+		Name:             "Jane Q Public",
+		EnrollmentNumber: "A1234567890",
+		IDCardNumber:     "11223344",
+		UUID:             "ABCDEF12-3456-7890-ABCD-EF1234567890",
+		DateOfBirth:      time.Date(2002, time.February, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "name in any case",
+			input:    "Welcome, JANE Q PUBLIC! (jane q  public)",
+			expected: "Welcome, JOHN DOE! (John Doe)",
+		},
+		{
+			name:     "username as a whole word",
+			input:    `Amizone_Id=7654321&other=176543210`,
+			expected: `Amizone_Id=fakeUsername&other=176543210`,
+		},
+		{
+			name:     "identifiers",
+			input:    "Enrollment No : A1234567890, ID Card No : 11223344, SUID=ABCDEF12-3456-7890-ABCD-EF1234567890",
+			expected: "Enrollment No : A2305221007, ID Card No : 95188911, SUID=98RFGK88-A01C-1JJO-N73D-4BJR42B33J51",
+		},
+		{
+			name:     "date of birth",
+			input:    "Date Of Birth : 03.02.2002",
+			expected: "Date Of Birth : 05.04.2001",
+		},
+		{
+			name:     "unknown identifiers by pattern",
+			input:    "A9999999999 11111111-2222-3333-4444-555555555555",
+			expected: "A2305221007 98RFGK88-A01C-1JJO-N73D-4BJR42B33J51",
+		},
+		{
+			name:     "macs consistently",
+			input:    "aa:bb:cc:dd:ee:ff AA-BB-CC-DD-EE-FF 11-22-33-44-55-66 77:88:99:aa:bb:cc",
+			expected: "55-04-2d-e7-be-a4 55-04-2d-e7-be-a4 fd-d5-14-18-0c-8b 02-00-00-00-00-02",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(cassette.NewAnonymiser(identity).String(testCase.input)).To(Equal(testCase.expected))
+		})
+	}
+}
+
+func TestAnonymiseCassette(t *testing.T) {
+	g := NewWithT(t)
+	dir, out := t.TempDir(), t.TempDir()
+	err := cassette.Save(dir, []cassette.Interaction{
+		{
+			Request:  cassette.Request{Method: http.MethodPost, URL: "/", Body: "_Password=REDACTED&_UserName=7654321"},
+			Response: cassette.Response{StatusCode: http.StatusFound, Header: http.Header{"Location": {"/Home?id=7654321"}}},
+		},
+		{
+			Request:  cassette.Request{Method: http.MethodGet, URL: "/RegisterForWifi/mac/MacRegistration"},
+			Response: cassette.Response{StatusCode: http.StatusOK, Body: `<input name="Amizone_Id" value="7654321" />`},
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(cassette.AnonymiseCassette(dir, out, cassette.Identity{})).To(Succeed())
+	interactions, err := cassette.Load(out)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(interactions).To(HaveLen(2))
+	g.Expect(interactions[0].Request.Body).To(Equal("_Password=REDACTED&_UserName=fakeUsername"), "the username should be found in the login request")
+	g.Expect(interactions[0].Response.Header.Get("Location")).To(Equal("/Home?id=fakeUsername"))
+	g.Expect(interactions[1].Response.Body).To(Equal(`<input name="Amizone_Id" value="fakeUsername" />`))
+}
@@ -0,0 +1,169 @@
+// Package cassette records HTTP traffic between the amizone client and Amizone into cassettes, directories of
+// JSON-encoded interactions, replays cassettes for deterministic tests, and anonymises recorded interactions so
+// they can be contributed as fixtures.
+package cassette
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Redacted replaces secrets, like passwords and cookie values, in recorded interactions.
+const Redacted = "REDACTED"
+
+// fileExtension is the extension of interaction files in cassettes.
+const fileExtension = ".json"
+
+// recordedHeaders are the headers kept in recorded interactions. Others are dropped, as they're either
+// irrelevant or sensitive.
+var recordedHeaders = []string{"Content-Type", "Location", "Set-Cookie"}
+
+// secretFormFields are the form fields redacted from recorded request bodies.
+var secretFormFields = []string{"_Password"}
+
+// Interaction is a recorded request to Amizone and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method string `json:"method"`
+	// URL is the path and query of the request, relative to the Amizone base URL.
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Load loads the interactions of the cassette in dir, in the order they were recorded.
+func Load(dir string) ([]Interaction, error) {
+	names, err := interactionFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	interactions := make([]Interaction, 0, len(names))
+	for _, name := range names {
+		raw, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		var interaction Interaction
+		if err := json.Unmarshal(raw, &interaction); err != nil {
+			return nil, fmt.Errorf("decode %s: %w", name, err)
+		}
+		interactions = append(interactions, interaction)
+	}
+	return interactions, nil
+}
+
+// Save saves interactions to a cassette in dir, creating it if it doesn't exist.
+func Save(dir string, interactions []Interaction) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for i, interaction := range interactions {
+		if err := writeInteraction(dir, i+1, interaction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FileName returns the name of the file for the seq'th interaction of a cassette.
+func FileName(seq int, interaction Interaction) string {
+	path, _, _ := strings.Cut(interaction.Request.URL, "?")
+	slug := strings.Trim(nonWordRegexp.ReplaceAllString(path, "_"), "_")
+	if slug == "" {
+		slug = "root"
+	}
+	return fmt.Sprintf("%04d-%s-%s%s", seq, interaction.Request.Method, slug, fileExtension)
+}
+
+var nonWordRegexp = regexp.MustCompile(`\W+`)
+
+func writeInteraction(dir string, seq int, interaction Interaction) error {
+	raw, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, FileName(seq, interaction)), raw, 0o600)
+}
+
+// interactionFiles returns the names of the interaction files in dir, in order.
+func interactionFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), fileExtension) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// filterHeader returns the recorded headers of header, with cookie values redacted.
+func filterHeader(header http.Header) http.Header {
+	filtered := http.Header{}
+	for _, key := range recordedHeaders {
+		for _, value := range header.Values(key) {
+			if key == "Set-Cookie" {
+				value = redactCookie(value)
+			}
+			filtered.Add(key, value)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
+
+// redactCookie redacts the value of a Set-Cookie header, keeping the name and attributes of the cookie.
+func redactCookie(setCookie string) string {
+	nameValue, attributes, hasAttributes := strings.Cut(setCookie, ";")
+	name, _, _ := strings.Cut(nameValue, "=")
+	redacted := name + "=" + Redacted
+	if hasAttributes {
+		redacted += ";" + attributes
+	}
+	return redacted
+}
+
+// RedactForm redacts secret fields, like passwords, from a URL-encoded form body. Bodies that aren't forms
+// are returned as is. It returns the redacted body and the secrets redacted.
+func RedactForm(body string) (string, []string) {
+	form, err := url.ParseQuery(body)
+	if err != nil {
+		return body, nil
+	}
+	var secrets []string
+	for _, field := range secretFormFields {
+		if value := form.Get(field); value != "" {
+			secrets = append(secrets, value)
+			form.Set(field, Redacted)
+		}
+	}
+	if len(secrets) == 0 {
+		return body, nil
+	}
+	return form.Encode(), secrets
+}
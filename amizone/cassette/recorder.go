@@ -0,0 +1,119 @@
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Recorder is an http.RoundTripper that records every request made through it and its response to a
+// cassette. Install it as the Transport of the *http.Client passed to amizone.NewClient to record a session:
+//
+//	recorder, err := cassette.NewRecorder("testdata/cassette", nil)
+//	client, err := amizone.NewClient(cred, &http.Client{Jar: jar, Transport: recorder})
+//
+// Passwords and cookie values are redacted from recordings, along with any echo of the password in responses,
+// but pages still hold personal information: use Anonymiser before sharing cassettes.
+type Recorder struct {
+	dir  string
+	next http.RoundTripper
+
+	mu  sync.Mutex
+	seq int
+	// secrets are the values redacted from requests so far, which are also redacted from responses.
+	secrets []string
+}
+
+// Interface compliance constraint for Recorder
+var _ http.RoundTripper = &Recorder{}
+
+// NewRecorder returns a Recorder that records the traffic it sends through next to the cassette in dir, which
+// is created if it doesn't exist. Interactions are appended to those already in the cassette. A nil next means
+// http.DefaultTransport.
+func NewRecorder(dir string, next http.RoundTripper) (*Recorder, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	existing, err := interactionFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{dir: dir, next: next, seq: len(existing)}, nil
+}
+
+// RoundTrip implements http.RoundTripper. Failure to record an interaction fails the request, so that
+// recordings aren't silently incomplete.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		requestBody, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	response, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	if err := r.record(req, requestBody, response, responseBody); err != nil {
+		return nil, fmt.Errorf("cassette: failed to record interaction: %w", err)
+	}
+	return response, nil
+}
+
+func (r *Recorder) record(req *http.Request, requestBody []byte, response *http.Response, responseBody []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	body, secrets := RedactForm(string(requestBody))
+	r.secrets = append(r.secrets, secrets...)
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.RequestURI(),
+			Header: filterHeader(req.Header),
+			Body:   body,
+		},
+		Response: Response{
+			StatusCode: response.StatusCode,
+			Header:     filterHeader(response.Header),
+			Body:       r.redactSecrets(string(responseBody)),
+		},
+	}
+
+	r.seq++
+	return writeInteraction(r.dir, r.seq, interaction)
+}
+
+// minSecretLength is the length below which secrets aren't redacted from responses, as redacting them would
+// mangle pages beyond use.
+const minSecretLength = 4
+
+// redactSecrets redacts the secrets seen in requests from s.
+func (r *Recorder) redactSecrets(s string) string {
+	for _, secret := range r.secrets {
+		if len(secret) < minSecretLength {
+			continue
+		}
+		s = strings.ReplaceAll(s, secret, Redacted)
+	}
+	return s
+}
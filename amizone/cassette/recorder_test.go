@@ -0,0 +1,69 @@
+package cassette_test
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/cassette"
+)
+
+func TestRecorder(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+	server := amizonetest.NewServer(t, nil)
+
+	recorder, err := cassette.NewRecorder(dir, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	jar, err := cookiejar.New(nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	client, err := amizone.NewClient(
+		amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword},
+		&http.Client{Jar: jar, Transport: recorder},
+		amizone.WithBaseURL(server.URL),
+		amizone.WithLogger(logr.Discard()),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = client.GetAttendance()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	interactions, err := cassette.Load(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	// The login page, the login request and its redirect, and the home page.
+	g.Expect(interactions).To(HaveLen(4))
+
+	login := interactions[1]
+	g.Expect(login.Request.Method).To(Equal(http.MethodPost))
+	g.Expect(login.Request.URL).To(Equal("/"))
+	g.Expect(login.Request.Body).To(ContainSubstring("_UserName=" + amizonetest.DefaultUsername))
+	g.Expect(login.Request.Body).ToNot(ContainSubstring(amizonetest.DefaultPassword))
+	g.Expect(login.Response.StatusCode).To(Equal(http.StatusFound))
+	g.Expect(login.Response.Header.Values("Set-Cookie")).ToNot(BeEmpty())
+	for _, cookie := range login.Response.Header.Values("Set-Cookie") {
+		g.Expect(cookie).To(MatchRegexp("^[^=]+=" + cassette.Redacted + ";"))
+	}
+
+	home := interactions[3]
+	g.Expect(home.Request.URL).To(Equal("/Home"))
+	g.Expect(home.Response.StatusCode).To(Equal(http.StatusOK))
+	g.Expect(home.Response.Body).To(ContainSubstring("My Attendance"))
+
+	t.Run("recordings are appended to", func(t *testing.T) {
+		g := NewWithT(t)
+		recorder, err := cassette.NewRecorder(dir, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = (&http.Client{Transport: recorder}).Get(server.URL + "/")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		interactions, err := cassette.Load(dir)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(interactions).To(HaveLen(5))
+		g.Expect(strings.Contains(interactions[4].Response.Body, "loginform")).To(BeTrue())
+	})
+}
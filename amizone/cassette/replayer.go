@@ -0,0 +1,143 @@
+package cassette
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ErrUnmatchedRequest is returned by Replayer for requests that don't match any recorded interaction.
+var ErrUnmatchedRequest = errors.New("cassette: no recorded interaction matches the request")
+
+// Replayer is an http.RoundTripper that serves recorded interactions in place of Amizone, so tests can run
+// against captured pages deterministically and offline. Install it as the Transport of the *http.Client passed
+// to amizone.NewClient.
+//
+// Requests are matched to interactions by method, path, query and body, with form bodies compared field by
+// field after redacting them as Recorder does. Requests that match several interactions are served the first
+// one not replayed yet, so a page recorded before and after a change is replayed in order; once all matches
+// have been replayed, the last one is served again. Requests that don't match any interaction fail with
+// ErrUnmatchedRequest.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// Interface compliance constraint for Replayer
+var _ http.RoundTripper = &Replayer{}
+
+// NewReplayer returns a Replayer for the cassette in dir.
+func NewReplayer(dir string) (*Replayer, error) {
+	interactions, err := Load(dir)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayerFromInteractions(interactions), nil
+}
+
+// NewReplayerFromInteractions returns a Replayer for interactions.
+func NewReplayerFromInteractions(interactions []Interaction) *Replayer {
+	return &Replayer{
+		interactions: interactions,
+		replayed:     make([]bool, len(interactions)),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	redactedBody, _ := RedactForm(string(body))
+
+	interaction, ok := r.match(req.Method, req.URL, redactedBody)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %s %q", ErrUnmatchedRequest, req.Method, req.URL.RequestURI(), redactedBody)
+	}
+
+	responseBody := []byte(interaction.Response.Body)
+	header := interaction.Response.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       req,
+	}, nil
+}
+
+// Unreplayed returns the interactions that haven't been replayed, so tests can check that a flow made all the
+// requests recorded.
+func (r *Replayer) Unreplayed() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unreplayed []Interaction
+	for i, interaction := range r.interactions {
+		if !r.replayed[i] {
+			unreplayed = append(unreplayed, interaction)
+		}
+	}
+	return unreplayed
+}
+
+// match returns the interaction to replay for a request, marking it replayed.
+func (r *Replayer) match(method string, u *url.URL, body string) (Interaction, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	last := -1
+	for i, interaction := range r.interactions {
+		if !matches(interaction.Request, method, u, body) {
+			continue
+		}
+		if !r.replayed[i] {
+			r.replayed[i] = true
+			return interaction, true
+		}
+		last = i
+	}
+	if last < 0 {
+		return Interaction{}, false
+	}
+	return r.interactions[last], true
+}
+
+// matches reports whether a request matches recorded.
+func matches(recorded Request, method string, u *url.URL, body string) bool {
+	if recorded.Method != method {
+		return false
+	}
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil || recordedURL.Path != u.Path || !reflect.DeepEqual(recordedURL.Query(), u.Query()) {
+		return false
+	}
+	if recorded.Body == body {
+		return true
+	}
+	// Form fields might have been encoded in another order.
+	recordedForm, err := url.ParseQuery(recorded.Body)
+	if err != nil || !strings.Contains(body, "=") {
+		return false
+	}
+	form, err := url.ParseQuery(body)
+	return err == nil && reflect.DeepEqual(recordedForm, form)
+}
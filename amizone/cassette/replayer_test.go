@@ -0,0 +1,83 @@
+package cassette_test
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone/cassette"
+)
+
+func TestReplayer(t *testing.T) {
+	interaction := func(method, url, body string, status int, responseBody string) cassette.Interaction {
+		return cassette.Interaction{
+			Request:  cassette.Request{Method: method, URL: url, Body: body},
+			Response: cassette.Response{StatusCode: status, Header: http.Header{"Content-Type": {"text/html"}}, Body: responseBody},
+		}
+	}
+	interactions := []cassette.Interaction{
+		interaction(http.MethodGet, "/Home", "", http.StatusOK, "home"),
+		interaction(http.MethodGet, "/Home", "", http.StatusOK, "home again"),
+		interaction(http.MethodGet, "/Calendar/home/GetDiaryEvents?start=2022-09-01&end=2022-09-02", "", http.StatusOK, "[]"),
+		interaction(http.MethodPost, "/", "_UserName=user&_Password="+cassette.Redacted, http.StatusFound, ""),
+	}
+
+	roundTrip := func(g *WithT, replayer *cassette.Replayer, method, target, body string) (*http.Response, string, error) {
+		request, err := http.NewRequest(method, "https://s.amizone.net"+target, strings.NewReader(body))
+		g.Expect(err).ToNot(HaveOccurred())
+		response, err := replayer.RoundTrip(request)
+		if err != nil {
+			return nil, "", err
+		}
+		raw, err := io.ReadAll(response.Body)
+		g.Expect(err).ToNot(HaveOccurred())
+		return response, string(raw), nil
+	}
+
+	t.Run("replays matches in order, then the last again", func(t *testing.T) {
+		g := NewWithT(t)
+		replayer := cassette.NewReplayerFromInteractions(interactions)
+		for _, expected := range []string{"home", "home again", "home again"} {
+			response, body, err := roundTrip(g, replayer, http.MethodGet, "/Home", "")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(response.StatusCode).To(Equal(http.StatusOK))
+			g.Expect(response.Header.Get("Content-Type")).To(Equal("text/html"))
+			g.Expect(body).To(Equal(expected))
+		}
+		g.Expect(replayer.Unreplayed()).To(HaveLen(2))
+	})
+
+	t.Run("matches queries and forms regardless of order", func(t *testing.T) {
+		g := NewWithT(t)
+		replayer := cassette.NewReplayerFromInteractions(interactions)
+		_, body, err := roundTrip(g, replayer, http.MethodGet, "/Calendar/home/GetDiaryEvents?end=2022-09-02&start=2022-09-01", "")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(body).To(Equal("[]"))
+
+		form := url.Values{"_Password": {"hunter22"}, "_UserName": {"user"}}
+		response, _, err := roundTrip(g, replayer, http.MethodPost, "/", form.Encode())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(response.StatusCode).To(Equal(http.StatusFound))
+	})
+
+	t.Run("fails unmatched requests", func(t *testing.T) {
+		g := NewWithT(t)
+		replayer := cassette.NewReplayerFromInteractions(interactions)
+		for _, request := range []struct{ method, target, body string }{
+			{http.MethodPost, "/Home", ""},
+			{http.MethodGet, "/IDCard", ""},
+			{http.MethodGet, "/Calendar/home/GetDiaryEvents?start=2022-09-02&end=2022-09-03", ""},
+			{http.MethodPost, "/", "_UserName=someone-else&_Password=hunter22"},
+		} {
+			_, _, err := roundTrip(g, replayer, request.method, request.target, request.body)
+			g.Expect(err).To(MatchError(cassette.ErrUnmatchedRequest))
+			g.Expect(err.Error()).To(ContainSubstring(request.method))
+			g.Expect(err.Error()).ToNot(ContainSubstring("hunter22"))
+		}
+		g.Expect(replayer.Unreplayed()).To(HaveLen(len(interactions)))
+	})
+}
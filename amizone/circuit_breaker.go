@@ -0,0 +1,129 @@
+package amizone
+
+import (
+	"sync"
+	"time"
+)
+
+// Defaults for NewCircuitBreaker.
+const (
+	DefaultCircuitFailureThreshold = 5
+	DefaultCircuitCooldown         = 30 * time.Second
+)
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed is the normal state of a CircuitBreaker, in which requests are let through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen is the state of a CircuitBreaker that determined Amizone to be down. Requests fail with
+	// ErrCircuitOpen without being made.
+	CircuitOpen
+	// CircuitHalfOpen is the state of a CircuitBreaker whose cooldown has elapsed. A single request is let
+	// through to probe Amizone, and its outcome determines whether the circuit closes or opens again.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreaker tracks failures of requests made to Amizone, and short-circuits requests while Amizone is
+// down instead of letting them time out. Requests that fail to reach Amizone or receive a 5xx status code count
+// as failures; the circuit opens after a number of consecutive failures, and is probed again after a cooldown.
+// A CircuitBreaker is safe for concurrent use, and is meant to be shared between clients through
+// WithCircuitBreaker, so that all of them benefit from what one of them learns.
+type CircuitBreaker struct {
+	failureThreshold int
+	cooldown         time.Duration
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker returns a CircuitBreaker that opens after failureThreshold consecutive failures, and lets a
+// probe through after cooldown. Non-positive values fall back to DefaultCircuitFailureThreshold and
+// DefaultCircuitCooldown.
+func NewCircuitBreaker(failureThreshold int, cooldown time.Duration) *CircuitBreaker {
+	if failureThreshold <= 0 {
+		failureThreshold = DefaultCircuitFailureThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultCircuitCooldown
+	}
+	return &CircuitBreaker{failureThreshold: failureThreshold, cooldown: cooldown}
+}
+
+// State returns the current state of the circuit.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.updateState()
+	return b.state
+}
+
+// allow returns ErrCircuitOpen if a request shouldn't be made, and nil otherwise. Every nil return must be
+// followed by a call to record with the outcome of the request.
+func (b *CircuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.updateState()
+
+	switch b.state {
+	case CircuitOpen:
+		return ErrCircuitOpen
+	case CircuitHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// record records the outcome of a request allowed by allow.
+func (b *CircuitBreaker) record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if success {
+		b.state = CircuitClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == CircuitHalfOpen || b.failures >= b.failureThreshold {
+		b.state = CircuitOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// release releases a request allowed by allow without recording an outcome, for requests that were
+// abandoned before their outcome could tell us anything about Amizone.
+func (b *CircuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// updateState moves an open circuit to half-open once its cooldown elapses. The caller must hold b.mu.
+func (b *CircuitBreaker) updateState() {
+	if b.state == CircuitOpen && time.Since(b.openedAt) >= b.cooldown {
+		b.state = CircuitHalfOpen
+	}
+}
@@ -0,0 +1,419 @@
+package amizone_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+// The contract suite calls every method of the client and checks invariants of what they return, which catch
+// parsers that still succeed on pages Amizone changed. TestIntegrateContract runs it against a live account,
+// and the tests in this file keep it honest against the fake portal.
+
+// Statuses of contract checks.
+const (
+	contractPass = "pass"
+	// contractFail is the status of checks whose calls succeeded, but returned results breaking invariants.
+	contractFail = "fail"
+	// contractError is the status of checks whose calls failed.
+	contractError = "error"
+	contractSkip  = "skip"
+)
+
+// contractMac is the MAC address the destructive Wi-Fi check registers and removes. It's locally administered,
+// so it can't clash with the address of a real device.
+var contractMac = net.HardwareAddr{0x02, 0x00, 0x5e, 0xc0, 0xff, 0xee}
+
+// contractReport is the machine-readable report of a run of the contract suite.
+type contractReport struct {
+	StartedAt   time.Time        `json:"started_at"`
+	Destructive bool             `json:"destructive"`
+	Passed      bool             `json:"passed"`
+	Checks      []contractResult `json:"checks"`
+}
+
+// result returns the result of the check named name, or a zero result if there's none.
+func (r *contractReport) result(name string) contractResult {
+	for _, result := range r.Checks {
+		if result.Name == name {
+			return result
+		}
+	}
+	return contractResult{}
+}
+
+// contractResult is the result of a contract check.
+type contractResult struct {
+	Name       string   `json:"name"`
+	Status     string   `json:"status"`
+	DurationMS int64    `json:"duration_ms"`
+	Error      string   `json:"error,omitempty"`
+	Violations []string `json:"violations,omitempty"`
+	// Note says why the check was skipped.
+	Note string `json:"note,omitempty"`
+}
+
+// expect records a violation, described by format and args, unless ok.
+func (r *contractResult) expect(ok bool, format string, args ...interface{}) {
+	if !ok {
+		r.Violations = append(r.Violations, fmt.Sprintf(format, args...))
+	}
+}
+
+// skip marks the check skipped, for reason.
+func (r *contractResult) skip(reason string) {
+	r.Status = contractSkip
+	r.Note = reason
+}
+
+// contractCheck checks the contract of a client method. run returns the error of the call, recording
+// violations of invariants in the result.
+type contractCheck struct {
+	name        string
+	destructive bool
+	run         func(client *amizone.Client, r *contractResult) error
+}
+
+// contractChecks are the checks of the contract suite, in the order they're run.
+var contractChecks = []contractCheck{
+	{name: "DidLogin", run: func(client *amizone.Client, r *contractResult) error {
+		r.expect(client.DidLogin(), "client isn't logged in")
+		return nil
+	}},
+	{name: "GetAttendance", run: func(client *amizone.Client, r *contractResult) error {
+		records, err := client.GetAttendance()
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			r.expect(record.Course.Code != "", "course %q has no code", record.Course.Name)
+			r.expect(record.Course.Name != "", "course %q has no name", record.Course.Code)
+			r.expect(record.ClassesAttended >= 0, "%s: %d classes attended", record.Course.Code, record.ClassesAttended)
+			r.expect(record.ClassesAttended <= record.ClassesHeld,
+				"%s: more classes attended (%d) than held (%d)", record.Course.Code, record.ClassesAttended, record.ClassesHeld)
+		}
+		return nil
+	}},
+	{name: "GetClassSchedule", run: func(client *amizone.Client, r *contractResult) error {
+		today := contractToday()
+		schedule, err := client.GetClassSchedule(today.Year(), today.Month(), today.Day())
+		if err != nil {
+			return err
+		}
+		for _, class := range schedule {
+			r.expect(class.Course.Name != "", "class at %s has no course name", class.StartTime)
+			r.expect(class.StartTime.Before(class.EndTime), "%s: class starts (%s) after it ends (%s)",
+				class.Course.Code, class.StartTime, class.EndTime)
+			r.expect(class.StartTime.Format("2006-01-02") == today.Format("2006-01-02"), "%s: class on %s, not today",
+				class.Course.Code, class.StartTime.Format("2006-01-02"))
+		}
+		return nil
+	}},
+	{name: "GetClassScheduleRange", run: func(client *amizone.Client, r *contractResult) error {
+		from := contractToday()
+		to := from.AddDate(0, 0, 6)
+		week, err := client.GetClassScheduleRange(from, to)
+		if err != nil {
+			return err
+		}
+		end := to.AddDate(0, 0, 1)
+		weekClasses := make(map[string]bool, len(week))
+		for _, class := range week {
+			r.expect(!class.StartTime.Before(from) && class.StartTime.Before(end), "%s: class on %s, outside %s to %s",
+				class.Course.Code, class.StartTime.Format("2006-01-02"), from.Format("2006-01-02"), to.Format("2006-01-02"))
+			weekClasses[contractClassKey(class)] = true
+		}
+
+		day, err := client.GetClassSchedule(from.Year(), from.Month(), from.Day())
+		if err != nil {
+			return fmt.Errorf("single day: %w", err)
+		}
+		for _, class := range day {
+			r.expect(weekClasses[contractClassKey(class)], "%s: class at %s is missing from the week",
+				class.Course.Code, class.StartTime)
+		}
+		return nil
+	}},
+	{name: "GetExamSchedule", run: func(client *amizone.Client, r *contractResult) error {
+		schedule, err := client.GetExamSchedule()
+		if err != nil {
+			return err
+		}
+		for _, exam := range schedule.Exams {
+			r.expect(exam.Course.Code != "", "exam of %q has no course code", exam.Course.Name)
+			r.expect(!exam.Time.IsZero(), "%s: exam has no time", exam.Course.Code)
+		}
+		return nil
+	}},
+	{name: "GetSemesters", run: func(client *amizone.Client, r *contractResult) error {
+		semesters, err := client.GetSemesters()
+		if err != nil {
+			return err
+		}
+		r.expect(len(semesters) > 0, "no semesters")
+		refs := make(map[string]bool)
+		for _, semester := range semesters {
+			r.expect(semester.Ref != "", "semester %q has no ref", semester.Name)
+			r.expect(semester.Name != "", "semester %q has no name", semester.Ref)
+			r.expect(!refs[semester.Ref], "semester ref %q is repeated", semester.Ref)
+			refs[semester.Ref] = true
+		}
+		return nil
+	}},
+	{name: "GetCurrentCourses", run: func(client *amizone.Client, r *contractResult) error {
+		courses, err := client.GetCurrentCourses()
+		if err != nil {
+			return err
+		}
+		expectCourses(r, "current semester", courses)
+		return nil
+	}},
+	{name: "GetCourses", run: func(client *amizone.Client, r *contractResult) error {
+		semesters, err := client.GetSemesters()
+		if err != nil {
+			return err
+		}
+		for _, semester := range semesters {
+			courses, err := client.GetCourses(semester.Ref)
+			if err != nil {
+				return fmt.Errorf("semester %s: %w", semester.Ref, err)
+			}
+			expectCourses(r, "semester "+semester.Ref, courses)
+		}
+		return nil
+	}},
+	{name: "GetCurrentExaminationResult", run: func(client *amizone.Client, r *contractResult) error {
+		result, err := client.GetCurrentExaminationResult()
+		if err != nil {
+			return err
+		}
+		expectExamResult(r, "current semester", result)
+		return nil
+	}},
+	{name: "GetExaminationResult", run: func(client *amizone.Client, r *contractResult) error {
+		semesters, err := client.GetSemesters()
+		if err != nil {
+			return err
+		}
+		if len(semesters) == 0 {
+			r.skip("no semesters to get results for")
+			return nil
+		}
+		// Semesters are listed latest first, and only semesters that are over have results: the last, oldest one
+		// is over unless it's the only one.
+		ref := semesters[len(semesters)-1].Ref
+		result, err := client.GetExaminationResult(ref)
+		if err != nil {
+			return fmt.Errorf("semester %s: %w", ref, err)
+		}
+		expectExamResult(r, "semester "+ref, result)
+		return nil
+	}},
+	{name: "GetUserProfile", run: func(client *amizone.Client, r *contractResult) error {
+		profile, err := client.GetUserProfile()
+		if err != nil {
+			return err
+		}
+		r.expect(profile.Name != "", "profile has no name")
+		r.expect(profile.EnrollmentNumber != "", "profile has no enrollment number")
+		r.expect(!profile.DateOfBirth.IsZero(), "profile has no date of birth")
+		r.expect(profile.DateOfBirth.Before(profile.EnrollmentValidity),
+			"date of birth (%s) is after the enrollment validity (%s)", profile.DateOfBirth, profile.EnrollmentValidity)
+		return nil
+	}},
+	{name: "GetWiFiMacInformation", run: func(client *amizone.Client, r *contractResult) error {
+		info, err := client.GetWiFiMacInformation()
+		if err != nil {
+			return err
+		}
+		r.expect(info.Slots > 0, "no slots for Wi-Fi addresses")
+		r.expect(len(info.RegisteredAddresses) <= info.Slots,
+			"more addresses registered (%d) than slots (%d)", len(info.RegisteredAddresses), info.Slots)
+		r.expect(info.FreeSlots == info.Slots-len(info.RegisteredAddresses),
+			"%d free slots, with %d of %d slots used", info.FreeSlots, len(info.RegisteredAddresses), info.Slots)
+		return nil
+	}},
+	{name: "CheckParsers", run: func(client *amizone.Client, r *contractResult) error {
+		report, err := client.CheckParsers()
+		if report == nil {
+			return err
+		}
+		for _, page := range report.Pages {
+			for _, finding := range page.Findings {
+				r.expect(false, "%s: %s %s %s", page.Page, finding.Selector, finding.Column, finding.Problem)
+			}
+		}
+		return err
+	}},
+	{name: "RegisterWifiMac+RemoveWifiMac", destructive: true, run: func(client *amizone.Client, r *contractResult) error {
+		info, err := client.GetWiFiMacInformation()
+		if err != nil {
+			return err
+		}
+		if !info.IsRegistered(contractMac) && !info.HasFreeSlot() {
+			r.skip("no free slot to register " + contractMac.String() + " in")
+			return nil
+		}
+		if err := client.RegisterWifiMac(contractMac, false); err != nil {
+			return fmt.Errorf("register: %w", err)
+		}
+		info, err = client.GetWiFiMacInformation()
+		if err != nil {
+			return err
+		}
+		r.expect(info.IsRegistered(contractMac), "%s isn't registered after registering it", contractMac)
+		if err := client.RemoveWifiMac(contractMac); err != nil {
+			return fmt.Errorf("remove: %w", err)
+		}
+		info, err = client.GetWiFiMacInformation()
+		if err != nil {
+			return err
+		}
+		r.expect(!info.IsRegistered(contractMac), "%s is registered after removing it", contractMac)
+		return nil
+	}},
+	{name: "SubmitFacultyFeedbackHack", destructive: true, run: func(_ *amizone.Client, r *contractResult) error {
+		r.skip("feedback submissions can't be undone")
+		return nil
+	}},
+}
+
+// contractIST is the time zone Amizone's wall-clock times are in.
+var contractIST = time.FixedZone("IST", 5*60*60+30*60)
+
+// contractToday returns the date it is in IST, as a midnight in UTC, like the wall-clock times of classes. Amizone's
+// days are IST days, whatever the time zone the suite runs in.
+func contractToday() time.Time {
+	now := time.Now().In(contractIST)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// contractClassKey identifies class among the classes of a schedule.
+func contractClassKey(class models.ScheduledClass) string {
+	return class.Course.Code + "@" + class.StartTime.Format(time.RFC3339)
+}
+
+// expectCourses records violations of the invariants of courses, described as what.
+func expectCourses(r *contractResult, what string, courses []models.Course) {
+	for _, course := range courses {
+		r.expect(course.Code != "", "%s: course %q has no code", what, course.Name)
+		r.expect(course.Attendance.ClassesAttended <= course.Attendance.ClassesHeld, "%s: %s: more classes attended (%d) than held (%d)",
+			what, course.Code, course.Attendance.ClassesAttended, course.Attendance.ClassesHeld)
+		if course.InternalMarks.Available() {
+			r.expect(course.InternalMarks.Have >= 0 && course.InternalMarks.Have <= course.InternalMarks.Max,
+				"%s: %s: internal marks %v out of %v", what, course.Code, course.InternalMarks.Have, course.InternalMarks.Max)
+		}
+	}
+}
+
+// expectExamResult records violations of the invariants of result, described as what.
+func expectExamResult(r *contractResult, what string, result *models.ExamResultRecords) {
+	for _, record := range result.CourseWise {
+		r.expect(record.Course.Code != "", "%s: result of %q has no course code", what, record.Course.Name)
+		r.expect(record.Score.GradePoint >= 0 && record.Score.GradePoint <= 10,
+			"%s: %s: grade point %d out of range", what, record.Course.Code, record.Score.GradePoint)
+		r.expect(record.Credits.Acquired >= 0 && record.Credits.Acquired <= record.Credits.Effective,
+			"%s: %s: %d credits acquired of %d", what, record.Course.Code, record.Credits.Acquired, record.Credits.Effective)
+	}
+	for _, overall := range result.Overall {
+		r.expect(overall.SemesterGradePointAverage >= 0 && overall.SemesterGradePointAverage <= 10,
+			"%s: semester %s: SGPA %v out of range", what, overall.Semester.Ref, overall.SemesterGradePointAverage)
+		r.expect(overall.CumulativeGradePointAverage >= 0 && overall.CumulativeGradePointAverage <= 10,
+			"%s: semester %s: CGPA %v out of range", what, overall.Semester.Ref, overall.CumulativeGradePointAverage)
+	}
+}
+
+// runContract runs the contract checks against client, skipping destructive checks unless destructive is set.
+func runContract(client *amizone.Client, destructive bool) *contractReport {
+	report := &contractReport{StartedAt: time.Now(), Destructive: destructive, Passed: true}
+	for _, check := range contractChecks {
+		result := contractResult{Name: check.name}
+		if check.destructive && !destructive {
+			result.skip("destructive checks aren't enabled")
+			report.Checks = append(report.Checks, result)
+			continue
+		}
+		start := time.Now()
+		err := check.run(client, &result)
+		result.DurationMS = time.Since(start).Milliseconds()
+		switch {
+		case err != nil:
+			result.Status = contractError
+			result.Error = err.Error()
+		case len(result.Violations) > 0:
+			result.Status = contractFail
+		case result.Status == "":
+			result.Status = contractPass
+		}
+		if result.Status == contractError || result.Status == contractFail {
+			report.Passed = false
+		}
+		report.Checks = append(report.Checks, result)
+	}
+	return report
+}
+
+// reportContract reports the results of the checks in report as subtests of t.
+func reportContract(t *testing.T, report *contractReport) {
+	for _, result := range report.Checks {
+		result := result
+		t.Run(result.Name, func(t *testing.T) {
+			switch result.Status {
+			case contractSkip:
+				t.Skip(result.Note)
+			case contractError:
+				t.Errorf("call failed: %s", result.Error)
+			}
+			for _, violation := range result.Violations {
+				t.Error(violation)
+			}
+		})
+	}
+}
+
+func TestContract(t *testing.T) {
+	// A user with no addresses registered, so the Wi-Fi check has a free slot.
+	scenario := amizonetest.NewScenario().WithUsers(amizonetest.PortalUser{
+		Username: defaultCredentials.Username,
+		Password: defaultCredentials.Password,
+		WifiMacs: []net.HardwareAddr{},
+	})
+	server := amizonetest.NewServer(t, scenario)
+	client, err := server.NewAmizoneClient(defaultCredentials)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	report := runContract(client, true)
+	reportContract(t, report)
+	g := NewWithT(t)
+	g.Expect(report.Passed).To(BeTrue())
+	g.Expect(report.Checks).To(HaveLen(len(contractChecks)))
+	g.Expect(report.result("RegisterWifiMac+RemoveWifiMac").Status).To(Equal(contractPass), "the Wi-Fi check should run")
+	g.Expect(server.Portal.WifiMacs(defaultCredentials.Username)).To(BeEmpty())
+}
+
+func TestContract_ReportsBreakage(t *testing.T) {
+	g := NewWithT(t)
+	scenario := amizonetest.NewScenario().
+		WithAttendance(amizonetest.AttendanceRecord("CSE101", "Programming", 50, 48)).
+		WithErrors("/Examination/ExamSchedule", http.StatusInternalServerError, -1)
+	server := amizonetest.NewServer(t, scenario)
+	client, err := server.NewAmizoneClient(defaultCredentials)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	report := runContract(client, false)
+	g.Expect(report.Passed).To(BeFalse())
+	g.Expect(report.result("GetAttendance").Status).To(Equal(contractFail))
+	g.Expect(report.result("GetAttendance").Violations).To(ConsistOf("CSE101: more classes attended (50) than held (48)"))
+	g.Expect(report.result("GetExamSchedule").Status).To(Equal(contractError))
+	g.Expect(report.result("GetExamSchedule").Error).ToNot(BeEmpty())
+	g.Expect(report.result("GetUserProfile").Status).To(Equal(contractPass))
+	g.Expect(report.result("RegisterWifiMac+RemoveWifiMac").Status).To(Equal(contractSkip))
+}
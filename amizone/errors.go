@@ -0,0 +1,99 @@
+package amizone
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ditsuke/go-amizone/amizone/internal/parse"
+)
+
+// Errors. Errors returned by the Client can be matched against these through errors.Is; errors that
+// are composed from another, like ErrInvalidCredentials, also match the error they're composed from.
+var (
+	ErrBadClient              = errors.New("the http client passed must have a cookie jar, or be nil")
+	ErrBadBaseURL             = errors.New("the base URL passed must be an absolute http(s) URL")
+	ErrFailedToVisitPage      = errors.New("failed to visit page")
+	ErrFailedToFetchPage      = errors.New("failed to fetch page")
+	ErrFailedToReadResponse   = errors.New("failed to read response body")
+	ErrNon200StatusCode       = errors.New("received non-200 status code from amizone - is it down?")
+	ErrFailedLogin            = errors.New("failed to login")
+	ErrInvalidCredentials     = fmt.Errorf("%w: invalid credentials", ErrFailedLogin)
+	ErrMissingCredentials     = fmt.Errorf("%w: no credentials", ErrFailedLogin)
+	ErrInternalFailure        = errors.New("internal failure")
+	ErrFailedToComposeRequest = fmt.Errorf("%w: failed to compose request", ErrInternalFailure)
+	ErrFailedToParsePage      = fmt.Errorf("%w: failed to parse page", ErrInternalFailure)
+	ErrInvalidMac             = errors.New("invalid MAC address passed")
+	ErrNoMacSlots             = errors.New("no free wifi mac slots")
+	ErrFailedToRegisterMac    = errors.New("failed to register mac address")
+	ErrFailedToRemoveMac      = errors.New("failed to remove mac address")
+	ErrInvalidRating          = errors.New("invalid rating")
+	ErrInvalidQueryRating     = errors.New("invalid query rating")
+	ErrEmptyComment           = errors.New("comment cannot be empty")
+	ErrInvalidDateRange       = errors.New("invalid date range")
+	ErrNoSession              = errors.New("the client has no authenticated session to export")
+	ErrBadSession             = errors.New("the session passed is malformed or from an incompatible version")
+	ErrSessionMismatch        = errors.New("the session passed belongs to a different user")
+	ErrCircuitOpen            = errors.New("amizone appears to be down: not making requests until it recovers")
+	ErrRateLimited            = errors.New("request could not be made within the rate limit")
+
+	// ErrNotLoggedIn is matched by parse errors for pages Amizone served the login page in place of,
+	// which usually means the session expired.
+	ErrNotLoggedIn = parse.ErrNotLoggedIn
+)
+
+// IsUnavailable returns true if err was caused by Amizone being down or unreachable, as opposed to, for
+// example, bad input or a page that failed to parse. Failures like these are usually temporary.
+func IsUnavailable(err error) bool {
+	if errors.Is(err, ErrCircuitOpen) || errors.Is(err, ErrFailedToVisitPage) {
+		return true
+	}
+	var httpErr *HTTPError
+	return errors.As(err, &httpErr) && httpErr.StatusCode >= http.StatusInternalServerError
+}
+
+// HTTPError is returned when Amizone responds to a request with a non-200 status code. It matches
+// ErrNon200StatusCode through errors.Is.
+type HTTPError struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("%s: %s %s: %d", ErrNon200StatusCode, e.Method, e.Endpoint, e.StatusCode)
+}
+
+// Is makes every *HTTPError match ErrNon200StatusCode.
+func (e *HTTPError) Is(target error) bool {
+	return target == ErrNon200StatusCode
+}
+
+// ParseError is returned (wrapped) when a page retrieved from Amizone could not be parsed. It carries the
+// name of the page and, where relevant, the selector that failed to match. It matches ErrFailedToParsePage
+// when wrapped by the Client.
+type ParseError = parse.Error
+
+// wrappedError composes a sentinel error with the error that caused it, so that the result matches both
+// through errors.Is, and the cause can be extracted through errors.As.
+type wrappedError struct {
+	sentinel error
+	cause    error
+}
+
+// wrapError returns an error that matches sentinel and wraps cause.
+func wrapError(sentinel error, cause error) error {
+	return &wrappedError{sentinel: sentinel, cause: cause}
+}
+
+func (e *wrappedError) Error() string {
+	return e.sentinel.Error() + ": " + e.cause.Error()
+}
+
+func (e *wrappedError) Is(target error) bool {
+	return errors.Is(e.sentinel, target)
+}
+
+func (e *wrappedError) Unwrap() error {
+	return e.cause
+}
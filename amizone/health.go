@@ -0,0 +1,200 @@
+package amizone
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ditsuke/go-amizone/amizone/internal/parse"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+// HealthMonitor checks the pages clients fetch from Amizone against what the parsers expect of them, and
+// aggregates the findings into a models.ParserHealthReport. Parsers often return zero values rather than fail
+// when Amizone changes its markup, so the report is how such changes are caught before users notice.
+// A HealthMonitor is safe for concurrent use, and is meant to be shared between clients through
+// WithHealthMonitor.
+type HealthMonitor struct {
+	sampleRate int
+
+	mu    sync.Mutex
+	pages map[string]*models.PageHealth
+	// fetched counts the pages of each kind reported by clients, checked or not.
+	fetched map[string]int
+	now     func() time.Time
+}
+
+// DefaultHealthSampleRate is the sample rate of HealthMonitor used by the API server. Checking a page costs about
+// as much as parsing it, so checking every page would double the parsing done on every call.
+const DefaultHealthSampleRate = 20
+
+// NewHealthMonitor returns an empty HealthMonitor checking one in every sampleRate pages of each kind clients
+// report to it, starting with the first. A sampleRate of 1 checks every page; non-positive values fall back to
+// DefaultHealthSampleRate.
+func NewHealthMonitor(sampleRate int) *HealthMonitor {
+	if sampleRate <= 0 {
+		sampleRate = DefaultHealthSampleRate
+	}
+	return &HealthMonitor{
+		sampleRate: sampleRate,
+		pages:      make(map[string]*models.PageHealth),
+		fetched:    make(map[string]int),
+		now:        time.Now,
+	}
+}
+
+// Report returns the findings aggregated so far, for the pages checked at least once, sorted by page.
+func (m *HealthMonitor) Report() models.ParserHealthReport {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	report := models.ParserHealthReport{Pages: make([]models.PageHealth, 0, len(m.pages))}
+	for _, page := range m.pages {
+		health := *page
+		health.Findings = append([]models.ParserFinding(nil), page.Findings...)
+		report.Pages = append(report.Pages, health)
+	}
+	sort.Slice(report.Pages, func(i, j int) bool {
+		return report.Pages[i].Page < report.Pages[j].Page
+	})
+	return report
+}
+
+// Reset discards the findings aggregated so far, like after a fix for them has been deployed.
+func (m *HealthMonitor) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pages = make(map[string]*models.PageHealth)
+	m.fetched = make(map[string]int)
+}
+
+// sample reports whether a page just fetched should be checked.
+func (m *HealthMonitor) sample(page string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fetched[page]++
+	return (m.fetched[page]-1)%m.sampleRate == 0
+}
+
+// observe checks body, the body of page, and aggregates the findings. Bodies that fail to parse make a
+// ProblemUnparseable finding, except for login pages served for expired sessions, which are ignored.
+func (m *HealthMonitor) observe(page string, body []byte) {
+	findings, err := parse.Check(page, bytes.NewReader(body))
+	if err != nil {
+		var parseErr *parse.Error
+		if !errors.As(err, &parseErr) || errors.Is(err, parse.ErrNotLoggedIn) {
+			return
+		}
+		findings = []models.ParserFinding{{Problem: models.ProblemUnparseable, Error: err.Error(), Count: 1}}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	health, ok := m.pages[page]
+	if !ok {
+		health = &models.PageHealth{Page: page}
+		m.pages[page] = health
+	}
+	health.Checks++
+	health.LastChecked = now
+	for _, finding := range findings {
+		merged := false
+		for i := range health.Findings {
+			known := &health.Findings[i]
+			if known.Selector == finding.Selector && known.Problem == finding.Problem {
+				known.Count++
+				known.LastSeen = now
+				known.Error = finding.Error
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			finding.LastSeen = now
+			health.Findings = append(health.Findings, finding)
+		}
+	}
+}
+
+// checkPage reports response, the response for page, to the client's HealthMonitor, if any, which checks it if
+// it's sampled. The response body is left for the parser to read.
+func (a *Client) checkPage(page string, response *http.Response) {
+	if a.healthMonitor == nil || !a.healthMonitor.sample(page) {
+		return
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	a.healthMonitor.observe(page, body)
+}
+
+// CheckParsers fetches every page the client parses and checks it against what the parsers expect of it,
+// returning a report of the expected elements and columns that are missing or empty. Pages that fail to be
+// fetched are left out of the report; the error returned is then that of the first of them.
+func (a *Client) CheckParsers() (*models.ParserHealthReport, error) {
+	return a.CheckParsersWithContext(context.Background())
+}
+
+// CheckParsersWithContext is like CheckParsers, but the requests made are bound to ctx.
+func (a *Client) CheckParsersWithContext(ctx context.Context) (*models.ParserHealthReport, error) {
+	today := time.Now()
+	pages := []struct {
+		page     string
+		endpoint string
+	}{
+		{parse.PageHome, attendancePageEndpoint},
+		{parse.PageCourses, currentCoursesEndpoint},
+		{parse.PageExaminationResult, currentExaminationResultEndpoint},
+		{parse.PageExaminationSchedule, examScheduleEndpoint},
+		{parse.PageIDCard, profileEndpoint},
+		{parse.PageWifiMacRegistration, getWifiMacsEndpoint},
+		{parse.PageFacultyFeedback, facultyBaseEndpoint},
+		{parse.PageDiaryEvents, fmt.Sprintf(
+			scheduleEndpointTemplate,
+			today.Format(classScheduleEndpointDateFormat),
+			today.AddDate(0, 0, 1).Format(classScheduleEndpointDateFormat),
+		)},
+	}
+
+	monitor := NewHealthMonitor(1)
+	var failed []string
+	var firstErr error
+	fail := func(page string, err error) {
+		failed = append(failed, page)
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	for _, p := range pages {
+		response, err := a.doRequest(ctx, true, http.MethodGet, p.endpoint, nil)
+		if err != nil {
+			a.logger.Info("request (check parsers)", "page", p.page, "error", err.Error())
+			fail(p.page, wrapError(ErrFailedToFetchPage, err))
+			continue
+		}
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			fail(p.page, wrapError(ErrFailedToReadResponse, err))
+			continue
+		}
+		monitor.observe(p.page, body)
+		if a.healthMonitor != nil {
+			a.healthMonitor.observe(p.page, body)
+		}
+	}
+
+	report := monitor.Report()
+	if firstErr != nil {
+		return &report, fmt.Errorf("failed to check pages %s: %w", strings.Join(failed, ", "), firstErr)
+	}
+	return &report, nil
+}
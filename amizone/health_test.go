@@ -0,0 +1,151 @@
+package amizone_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/internal/mock"
+	"github.com/ditsuke/go-amizone/amizone/internal/parse"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+var defaultCredentials = amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword}
+
+func TestHealthMonitor(t *testing.T) {
+	g := NewWithT(t)
+	server := amizonetest.NewServer(t, nil)
+	monitor := amizone.NewHealthMonitor(1)
+	client, err := server.NewAmizoneClient(defaultCredentials, amizone.WithHealthMonitor(monitor))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = client.GetAttendance()
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = client.GetUserProfile()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	report := monitor.Report()
+	g.Expect(report.Healthy()).To(BeTrue())
+	g.Expect(report.Pages).To(HaveLen(2))
+	g.Expect(report.Pages[0].Page).To(Equal(parse.PageHome))
+	g.Expect(report.Pages[0].Checks).To(Equal(1))
+	g.Expect(report.Pages[1].Page).To(Equal(parse.PageIDCard))
+
+	t.Run("aggregates findings", func(t *testing.T) {
+		g := NewWithT(t)
+		// A portal whose ID card page lost the back of the card.
+		idCard, err := mock.IDCardPage.Open()
+		g.Expect(err).ToNot(HaveOccurred())
+		page, err := io.ReadAll(idCard)
+		g.Expect(err).ToNot(HaveOccurred())
+		broken := strings.ReplaceAll(string(page), "lblInfoIDCardBack1", "lblInfoBack")
+		brokenServer := httptest.NewServer(changePage(server.Config.Handler, "/IDCard", broken))
+		t.Cleanup(brokenServer.Close)
+		client, err := amizone.NewClient(
+			defaultCredentials,
+			nil,
+			amizone.WithBaseURL(brokenServer.URL),
+			amizone.WithLogger(logr.Discard()),
+			amizone.WithHealthMonitor(monitor),
+		)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		for i := 0; i < 2; i++ {
+			_, err = client.GetUserProfile()
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+
+		report := monitor.Report()
+		g.Expect(report.Healthy()).To(BeFalse())
+		idCardHealth := report.Pages[1]
+		g.Expect(idCardHealth.Checks).To(Equal(3))
+		g.Expect(idCardHealth.Findings).To(HaveLen(1))
+		g.Expect(idCardHealth.Findings[0].Selector).To(Equal("#lblInfoIDCardBack1"))
+		g.Expect(idCardHealth.Findings[0].Problem).To(Equal(models.ProblemMissing))
+		g.Expect(idCardHealth.Findings[0].Count).To(Equal(2))
+
+		monitor.Reset()
+		g.Expect(monitor.Report().Pages).To(BeEmpty())
+	})
+
+	t.Run("reports unparseable pages", func(t *testing.T) {
+		g := NewWithT(t)
+		monitor := amizone.NewHealthMonitor(1)
+		// A portal whose diary events endpoint serves an error page rather than JSON.
+		brokenServer := httptest.NewServer(changePage(server.Config.Handler, "/Calendar/home/GetDiaryEvents", "<html>Server Error</html>"))
+		t.Cleanup(brokenServer.Close)
+		client, err := amizone.NewClient(
+			defaultCredentials,
+			nil,
+			amizone.WithBaseURL(brokenServer.URL),
+			amizone.WithLogger(logr.Discard()),
+			amizone.WithHealthMonitor(monitor),
+		)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = client.GetClassSchedule(2022, time.September, 5)
+		g.Expect(err).To(HaveOccurred())
+
+		report := monitor.Report()
+		g.Expect(report.Healthy()).To(BeFalse())
+		g.Expect(report.Pages).To(HaveLen(1))
+		diary := report.Pages[0]
+		g.Expect(diary.Page).To(Equal(parse.PageDiaryEvents))
+		g.Expect(diary.Findings).To(HaveLen(1))
+		g.Expect(diary.Findings[0].Problem).To(Equal(models.ProblemUnparseable))
+		g.Expect(diary.Findings[0].Selector).To(BeEmpty())
+		g.Expect(diary.Findings[0].Error).To(ContainSubstring("JSON decode"))
+		g.Expect(diary.Findings[0].Count).To(Equal(1))
+	})
+
+	t.Run("samples pages", func(t *testing.T) {
+		g := NewWithT(t)
+		monitor := amizone.NewHealthMonitor(3)
+		client, err := server.NewAmizoneClient(defaultCredentials, amizone.WithHealthMonitor(monitor))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		for i := 0; i < 4; i++ {
+			_, err = client.GetUserProfile()
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+		report := monitor.Report()
+		g.Expect(report.Pages).To(HaveLen(1))
+		g.Expect(report.Pages[0].Checks).To(Equal(2), "the first and fourth pages should be checked")
+	})
+}
+
+func TestClient_CheckParsers(t *testing.T) {
+	g := NewWithT(t)
+	server := amizonetest.NewServer(t, nil)
+	client, err := server.NewAmizoneClient(defaultCredentials)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	report, err := client.CheckParsers()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(report.Healthy()).To(BeTrue(), "the fake portal serves the fixtures, which the parsers are written for")
+	var pages []string
+	for _, page := range report.Pages {
+		pages = append(pages, page.Page)
+	}
+	g.Expect(pages).To(Equal(parse.CheckedPages()))
+}
+
+// changePage wraps handler to serve body for requests to path.
+func changePage(handler http.Handler, path string, body string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(body))
+	})
+}
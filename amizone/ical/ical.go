@@ -0,0 +1,270 @@
+// Package ical converts Amizone class and exam schedules to RFC 5545 iCalendar, for calendar apps.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+// TimeZone is the time zone of the times Amizone reports, which the models carry as wall-clock times in UTC.
+// Events are written in this time zone, with a VTIMEZONE for it.
+const TimeZone = "Asia/Kolkata"
+
+// Defaults for Options.
+const (
+	DefaultName         = "Amizone"
+	DefaultDomain       = "go-amizone"
+	DefaultExamDuration = 3 * time.Hour
+)
+
+const (
+	productID = "-//ditsuke//go-amizone//EN"
+	// maxLineOctets is the length content lines are folded at, excluding the line break.
+	maxLineOctets = 75
+	// dateTimeFormat is the format of local DATE-TIME values; UTC values take a "Z" suffix.
+	dateTimeFormat = "20060102T150405"
+)
+
+// Options configures a Calendar.
+type Options struct {
+	// Name is the name calendar apps show for the calendar. Defaults to DefaultName.
+	Name string
+	// Domain is the domain event UIDs are qualified with, and should identify whoever publishes the calendar.
+	// Defaults to DefaultDomain.
+	Domain string
+	// Stamp is the time the calendar is created at, written as the DTSTAMP of events. Defaults to time.Now().
+	Stamp time.Time
+	// ExamDuration is how long exams are taken to last, since Amizone only reports when they start. Defaults
+	// to DefaultExamDuration.
+	ExamDuration time.Duration
+}
+
+// event is a VEVENT of a Calendar.
+type event struct {
+	// uid is the UID of the event, short of the domain it's qualified with.
+	uid         string
+	start, end  time.Time
+	summary     string
+	location    string
+	description string
+	category    string
+}
+
+// Calendar is an iCalendar of classes and exams. Each class and exam is an event, with a UID derived from the
+// course and the time it starts at, so that calendar apps see the same events when a calendar is created again
+// from fresh schedules.
+type Calendar struct {
+	options Options
+	events  []event
+}
+
+// New returns an empty Calendar configured by options.
+func New(options Options) *Calendar {
+	if options.Name == "" {
+		options.Name = DefaultName
+	}
+	if options.Domain == "" {
+		options.Domain = DefaultDomain
+	}
+	if options.Stamp.IsZero() {
+		options.Stamp = time.Now()
+	}
+	if options.ExamDuration <= 0 {
+		options.ExamDuration = DefaultExamDuration
+	}
+	return &Calendar{options: options}
+}
+
+// AddClasses adds the classes of schedule to the calendar. Schedules fetched for a range of dates can be added
+// one after another.
+func (c *Calendar) AddClasses(schedule models.ClassSchedule) {
+	for _, class := range schedule {
+		var description []string
+		if class.Faculty != "" {
+			description = append(description, "Faculty: "+class.Faculty)
+		}
+		if attendance := attendanceStates[class.Attended]; attendance != "" {
+			description = append(description, "Attendance: "+attendance)
+		}
+		c.events = append(c.events, event{
+			uid:         uid("class", class.Course.Code, class.StartTime),
+			start:       class.StartTime,
+			end:         class.EndTime,
+			summary:     summary(class.Course),
+			location:    class.Room,
+			description: strings.Join(description, "\n"),
+			category:    "Class",
+		})
+	}
+}
+
+// AddExams adds the exams of schedule to the calendar.
+func (c *Calendar) AddExams(schedule *models.ExaminationSchedule) {
+	if schedule == nil {
+		return
+	}
+	for _, exam := range schedule.Exams {
+		description := []string{schedule.Title}
+		if exam.Mode != "" {
+			description = append(description, "Mode: "+exam.Mode)
+		}
+		c.events = append(c.events, event{
+			uid:         uid("exam", exam.Course.Code, exam.Time),
+			start:       exam.Time,
+			end:         exam.Time.Add(c.options.ExamDuration),
+			summary:     summary(exam.Course),
+			location:    exam.Location,
+			description: strings.TrimSpace(strings.Join(description, "\n")),
+			category:    "Exam",
+		})
+	}
+}
+
+// WriteTo writes the calendar to w, with its events sorted by the time they start at. It implements
+// io.WriterTo.
+func (c *Calendar) WriteTo(w io.Writer) (int64, error) {
+	events := make([]event, len(c.events))
+	copy(events, c.events)
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].start.Equal(events[j].start) {
+			return events[i].start.Before(events[j].start)
+		}
+		return events[i].uid < events[j].uid
+	})
+
+	cw := &contentWriter{w: bufio.NewWriter(w)}
+	cw.line("BEGIN:VCALENDAR")
+	cw.line("VERSION:2.0")
+	cw.line("PRODID:" + productID)
+	cw.line("CALSCALE:GREGORIAN")
+	cw.line("METHOD:PUBLISH")
+	cw.line("X-WR-CALNAME:" + escapeText(c.options.Name))
+	cw.line("X-WR-TIMEZONE:" + TimeZone)
+	// India hasn't observed daylight saving time since 1945, so a single observance describes the time zone.
+	cw.line("BEGIN:VTIMEZONE")
+	cw.line("TZID:" + TimeZone)
+	cw.line("BEGIN:STANDARD")
+	cw.line("DTSTART:19700101T000000")
+	cw.line("TZOFFSETFROM:+0530")
+	cw.line("TZOFFSETTO:+0530")
+	cw.line("TZNAME:IST")
+	cw.line("END:STANDARD")
+	cw.line("END:VTIMEZONE")
+
+	stamp := c.options.Stamp.UTC().Format(dateTimeFormat) + "Z"
+	seen := make(map[string]int, len(events))
+	for _, e := range events {
+		// Amizone lists a class twice now and then, when it's held for several batches in different rooms.
+		id := e.uid
+		if seen[e.uid]++; seen[e.uid] > 1 {
+			id = fmt.Sprintf("%s-%d", e.uid, seen[e.uid])
+		}
+		cw.line("BEGIN:VEVENT")
+		cw.line("UID:" + id + "@" + c.options.Domain)
+		cw.line("DTSTAMP:" + stamp)
+		cw.line("DTSTART;TZID=" + TimeZone + ":" + localTime(e.start))
+		cw.line("DTEND;TZID=" + TimeZone + ":" + localTime(e.end))
+		cw.line("SUMMARY:" + escapeText(e.summary))
+		if e.location != "" {
+			cw.line("LOCATION:" + escapeText(e.location))
+		}
+		if e.description != "" {
+			cw.line("DESCRIPTION:" + escapeText(e.description))
+		}
+		cw.line("CATEGORIES:" + escapeText(e.category))
+		cw.line("TRANSP:OPAQUE")
+		cw.line("END:VEVENT")
+	}
+	cw.line("END:VCALENDAR")
+
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.n, cw.err
+}
+
+// attendanceStates describes the attendance states worth showing in event descriptions.
+var attendanceStates = map[models.AttendanceState]string{
+	models.AttendanceStatePresent: "present",
+	models.AttendanceStateAbsent:  "absent",
+}
+
+// uid returns the UID of the event of the kind passed for the course with code starting at start, short of the
+// domain it's qualified with.
+func uid(kind, code string, start time.Time) string {
+	return kind + "-" + uidSafe(code) + "-" + localTime(start)
+}
+
+// uidSafe replaces the characters of s other than ASCII letters, digits and hyphens, which are all UIDs are
+// made of, with hyphens.
+func uidSafe(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' {
+			return r
+		}
+		return '-'
+	}, strings.TrimSpace(s))
+}
+
+// summary returns the summary of the events for course.
+func summary(course models.CourseRef) string {
+	name := strings.Join(strings.Fields(course.Name), " ")
+	switch {
+	case name == "":
+		return course.Code
+	case course.Code == "":
+		return name
+	}
+	return name + " (" + course.Code + ")"
+}
+
+// localTime formats the wall-clock time of t, which is in TimeZone as far as Amizone is concerned.
+func localTime(t time.Time) string {
+	return t.Format(dateTimeFormat)
+}
+
+// escapeText escapes s as a TEXT value.
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`).Replace(s)
+}
+
+// contentWriter writes content lines, folded and terminated as RFC 5545 requires. Its first error sticks, and
+// is reported by WriteTo.
+type contentWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+// line writes the content line l, folding it into lines of at most maxLineOctets octets without splitting
+// UTF-8 sequences.
+func (cw *contentWriter) line(l string) {
+	limit := maxLineOctets
+	for len(l) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(l[cut]) {
+			cut--
+		}
+		cw.write(l[:cut] + "\r\n ")
+		l = l[cut:]
+		// Continuation lines start with a space, which counts towards their length.
+		limit = maxLineOctets - 1
+	}
+	cw.write(l + "\r\n")
+}
+
+func (cw *contentWriter) write(s string) {
+	if cw.err != nil {
+		return
+	}
+	n, err := cw.w.WriteString(s)
+	cw.n += int64(n)
+	cw.err = err
+}
@@ -0,0 +1,153 @@
+package ical_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone/ical"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the iCalendar tests")
+
+// stamp is the time the calendars under test are created at.
+var stamp = time.Date(2022, time.September, 4, 18, 30, 0, 0, time.UTC)
+
+var classes = models.ClassSchedule{
+	{
+		Course:    models.CourseRef{Code: "CSE208", Name: "Database Management Systems"},
+		StartTime: time.Date(2022, time.September, 5, 10, 15, 0, 0, time.UTC),
+		EndTime:   time.Date(2022, time.September, 5, 11, 10, 0, 0, time.UTC),
+		Faculty:   "Dr. Jane Doe, Dr. John Doe",
+		Room:      "E1-412",
+		Attended:  models.AttendanceStatePresent,
+	},
+	{
+		Course:    models.CourseRef{Code: "MATH242", Name: "Applied\n  Mathematics-IV"},
+		StartTime: time.Date(2022, time.September, 5, 9, 15, 0, 0, time.UTC),
+		EndTime:   time.Date(2022, time.September, 5, 10, 10, 0, 0, time.UTC),
+		Faculty:   "Dr. Richard Roe",
+		Room:      "E2-101; Lab 3",
+		Attended:  models.AttendanceStatePending,
+	},
+	// The same class for a second batch, in a different room.
+	{
+		Course:    models.CourseRef{Code: "CSE208", Name: "Database Management Systems"},
+		StartTime: time.Date(2022, time.September, 5, 10, 15, 0, 0, time.UTC),
+		EndTime:   time.Date(2022, time.September, 5, 11, 10, 0, 0, time.UTC),
+		Room:      "E1-413",
+		Attended:  models.AttendanceStateAbsent,
+	},
+	{
+		Course:    models.CourseRef{Code: "CSE208", Name: "Database Management Systems"},
+		StartTime: time.Date(2022, time.September, 6, 10, 15, 0, 0, time.UTC),
+		EndTime:   time.Date(2022, time.September, 6, 11, 10, 0, 0, time.UTC),
+		Faculty:   "Dr. Jane Doe, Dr. John Doe",
+		Room:      "E1-412",
+	},
+}
+
+var exams = &models.ExaminationSchedule{
+	Title: "End Semester Examination",
+	Exams: []models.ScheduledExam{
+		{
+			Course:   models.CourseRef{Code: "FREN144", Name: "Français - Niveau II: compréhension écrite et expression orale"},
+			Time:     time.Date(2022, time.September, 12, 10, 0, 0, 0, time.UTC),
+			Mode:     "Offline",
+			Location: "Block E, Room 412",
+		},
+	},
+}
+
+func TestCalendar(t *testing.T) {
+	g := NewWithT(t)
+	calendar := ical.New(ical.Options{Name: "Amizone: John Doe", Stamp: stamp})
+	calendar.AddClasses(classes)
+	calendar.AddExams(exams)
+
+	var out bytes.Buffer
+	n, err := calendar.WriteTo(&out)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(n).To(BeEquivalentTo(out.Len()))
+
+	path := filepath.Join("testdata", "calendar.ics")
+	if *updateGolden {
+		g.Expect(os.WriteFile(path, out.Bytes(), 0o644)).To(Succeed())
+	}
+	golden, err := os.ReadFile(path)
+	g.Expect(err).ToNot(HaveOccurred(), "run with -update to create the golden file")
+	g.Expect(out.String()).To(Equal(string(golden)), "run with -update to update the golden file, and review the diff")
+}
+
+func TestCalendar_Format(t *testing.T) {
+	g := NewWithT(t)
+	calendar := ical.New(ical.Options{Stamp: stamp})
+	calendar.AddClasses(classes)
+	calendar.AddExams(exams)
+	var out strings.Builder
+	_, err := calendar.WriteTo(&out)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(out.String()).To(HaveSuffix("END:VCALENDAR\r\n"))
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\r\n"), "\r\n")
+	folded := 0
+	for _, line := range lines {
+		g.Expect(len(line)).To(BeNumerically("<=", 75), "lines should be folded at 75 octets: %q", line)
+		g.Expect(utf8.ValidString(line)).To(BeTrue(), "lines should be folded between characters: %q", line)
+		g.Expect(line).ToNot(ContainSubstring("\n"))
+		if strings.HasPrefix(line, " ") {
+			folded++
+		}
+	}
+	g.Expect(folded).ToNot(BeZero())
+
+	unfolded := strings.ReplaceAll(out.String(), "\r\n ", "")
+	g.Expect(unfolded).To(ContainSubstring("X-WR-CALNAME:Amizone\r\n"))
+	g.Expect(unfolded).To(ContainSubstring(
+		"SUMMARY:Français - Niveau II: compréhension écrite et expression orale (FREN144)\r\n"))
+	g.Expect(unfolded).To(ContainSubstring("LOCATION:E2-101\\; Lab 3\r\n"))
+	g.Expect(unfolded).To(ContainSubstring("DESCRIPTION:Faculty: Dr. Jane Doe\\, Dr. John Doe\\nAttendance: present\r\n"))
+	g.Expect(unfolded).To(ContainSubstring("DTSTART;TZID=Asia/Kolkata:20220912T100000\r\nDTEND;TZID=Asia/Kolkata:20220912T130000\r\n"),
+		"exams should last the default duration")
+}
+
+func TestCalendar_StableUIDs(t *testing.T) {
+	g := NewWithT(t)
+	uids := func(schedule models.ClassSchedule, stamp time.Time) []string {
+		calendar := ical.New(ical.Options{Domain: "amizone.example.com", Stamp: stamp})
+		calendar.AddClasses(schedule)
+		var out strings.Builder
+		_, err := calendar.WriteTo(&out)
+		g.Expect(err).ToNot(HaveOccurred())
+		var uids []string
+		for _, line := range strings.Split(out.String(), "\r\n") {
+			if strings.HasPrefix(line, "UID:") {
+				uids = append(uids, strings.TrimPrefix(line, "UID:"))
+			}
+		}
+		return uids
+	}
+
+	first := uids(classes, stamp)
+	g.Expect(first).To(Equal([]string{
+		"class-MATH242-20220905T091500@amizone.example.com",
+		"class-CSE208-20220905T101500@amizone.example.com",
+		"class-CSE208-20220905T101500-2@amizone.example.com",
+		"class-CSE208-20220906T101500@amizone.example.com",
+	}))
+
+	// A class keeps its UID when the calendar is created again later, with the attendance marked.
+	updated := make(models.ClassSchedule, len(classes))
+	copy(updated, classes)
+	updated[0].Attended = models.AttendanceStateAbsent
+	g.Expect(uids(updated, stamp.Add(time.Hour))).To(Equal(first))
+	g.Expect(uids(updated[3:], stamp)).To(Equal(first[3:]), "UIDs shouldn't depend on the range of dates covered")
+}
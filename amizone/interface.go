@@ -1,6 +1,8 @@
 package amizone
 
 import (
+	"context"
+	"net"
 	"net/http"
 	"time"
 
@@ -8,11 +10,39 @@ import (
 )
 
 // ClientInterface is an exported interface for client to make mocking and testing more convenient.
+// It covers the entire API surface of Client; the amizonetest package provides a mock implementation.
 type ClientInterface interface {
 	DidLogin() bool
+
 	GetAttendance() (models.AttendanceRecords, error)
+	GetAttendanceWithContext(ctx context.Context) (models.AttendanceRecords, error)
+	GetCurrentExaminationResult() (*models.ExamResultRecords, error)
+	GetCurrentExaminationResultWithContext(ctx context.Context) (*models.ExamResultRecords, error)
+	GetExaminationResult(semesterRef string) (*models.ExamResultRecords, error)
+	GetExaminationResultWithContext(ctx context.Context, semesterRef string) (*models.ExamResultRecords, error)
 	GetClassSchedule(year int, month time.Month, date int) (models.ClassSchedule, error)
+	GetClassScheduleWithContext(ctx context.Context, year int, month time.Month, date int) (models.ClassSchedule, error)
+	GetClassScheduleRange(from, to time.Time) (models.ClassSchedule, error)
+	GetClassScheduleRangeWithContext(ctx context.Context, from, to time.Time) (models.ClassSchedule, error)
 	GetExamSchedule() (*models.ExaminationSchedule, error)
+	GetExamScheduleWithContext(ctx context.Context) (*models.ExaminationSchedule, error)
+	GetSemesters() (models.SemesterList, error)
+	GetSemestersWithContext(ctx context.Context) (models.SemesterList, error)
+	GetCourses(semesterRef string) (models.Courses, error)
+	GetCoursesWithContext(ctx context.Context, semesterRef string) (models.Courses, error)
+	GetCurrentCourses() (models.Courses, error)
+	GetCurrentCoursesWithContext(ctx context.Context) (models.Courses, error)
+	GetUserProfile() (*models.Profile, error)
+	GetUserProfileWithContext(ctx context.Context) (*models.Profile, error)
+	GetWiFiMacInformation() (*models.WifiMacInfo, error)
+	GetWiFiMacInformationWithContext(ctx context.Context) (*models.WifiMacInfo, error)
+
+	RegisterWifiMac(addr net.HardwareAddr, bypassLimit bool) error
+	RegisterWifiMacWithContext(ctx context.Context, addr net.HardwareAddr, bypassLimit bool) error
+	RemoveWifiMac(addr net.HardwareAddr) error
+	RemoveWifiMacWithContext(ctx context.Context, addr net.HardwareAddr) error
+	SubmitFacultyFeedbackHack(rating int32, queryRating int32, comment string) (int32, error)
+	SubmitFacultyFeedbackHackWithContext(ctx context.Context, rating int32, queryRating int32, comment string) (int32, error)
 }
 
 // Interface compliance constraint for Client
@@ -21,10 +51,18 @@ var _ ClientInterface = &Client{}
 // ClientFactoryInterface is a type for functions that return ClientInterface
 // instances. Functions returning concrete types need to be wrapped by functions
 // that return the interface; apparently a limitation of the Go compiler's type
-// inference.
-type ClientFactoryInterface func(cred Credentials, httpClient *http.Client) (ClientInterface, error)
+// inference. The parameters are the same as for NewClientWithContext.
+type ClientFactoryInterface func(ctx context.Context, cred Credentials, httpClient *http.Client, opts ...ClientOption) (ClientInterface, error)
 
-// Interface compliance constraint for NewClient [requires wrapping]
-var _ ClientFactoryInterface = func(cred Credentials, httpClient *http.Client) (ClientInterface, error) {
-	return NewClient(cred, httpClient)
+// DefaultClientFactory is a ClientFactoryInterface that creates clients with NewClientWithContext.
+func DefaultClientFactory(ctx context.Context, cred Credentials, httpClient *http.Client, opts ...ClientOption) (ClientInterface, error) {
+	client, err := NewClientWithContext(ctx, cred, httpClient, opts...)
+	if err != nil {
+		// Avoid returning a typed nil wrapped in a non-nil interface.
+		return nil, err
+	}
+	return client, nil
 }
+
+// Interface compliance constraint for DefaultClientFactory
+var _ ClientFactoryInterface = DefaultClientFactory
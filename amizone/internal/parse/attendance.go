@@ -1,7 +1,6 @@
 package parse
 
 import (
-	"errors"
 	"fmt"
 	"io"
 	"strconv"
@@ -12,56 +11,87 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// Markup of the attendance widget on the home page.
+const (
+	attendanceWidgetTitle = "My Attendance"
+
+	selectorWidgetHeader         = ".widget-header"
+	selectorAttendanceList       = "ul#tasks li"
+	selectorAttendanceCount      = "div.class-count span"
+	selectorAttendanceCourseCode = "span.sub-code"
+	selectorAttendanceCourseName = "span.lbl"
+)
+
+var homeSchema = pageSchema{
+	elements: []string{fmt.Sprintf("%s:containsOwn('%s')", selectorWidgetHeader, attendanceWidgetTitle)},
+	rows:     selectorAttendanceList,
+	cells: []cellSchema{
+		{selector: selectorAttendanceCount},
+		{selector: selectorAttendanceCourseCode},
+		{selector: selectorAttendanceCourseName},
+	},
+}
+
 // Attendance attempts to parse course attendance information from the Amizone home page
 // into a models.AttendanceRecords instance.
 func Attendance(body io.Reader) (models.AttendanceRecords, error) {
-	const (
-		AttendanceTableTitle = "My Attendance"
-	)
-
 	dom, err := goquery.NewDocumentFromReader(body)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", ErrFailedToParseDOM, err)
+		return nil, errDOM(PageHome, err)
 	}
 
 	if !IsLoggedInDOM(dom) {
-		return nil, errors.New(ErrNotLoggedIn)
+		return nil, errNotLoggedIn(PageHome)
 	}
 
 	// The attendance record is stored in a div-soup "widget". There are no semantic identifiers in the markup,
 	// so we search this widget by title.
-	attendanceWidgetHeader := dom.Find(".widget-header").
-		Filter(fmt.Sprintf(":containsOwn('%s')", AttendanceTableTitle))
-	attendanceList := attendanceWidgetHeader.Parent().Find("ul#tasks li")
-
-	if attendanceWidgetHeader.Length() == 0 || attendanceList.Length() == 0 {
+	attendanceWidgetHeader := dom.Find(selectorWidgetHeader).
+		Filter(fmt.Sprintf(":containsOwn('%s')", attendanceWidgetTitle))
+	if attendanceWidgetHeader.Length() == 0 {
 		klog.Warning("Failed to find the attendance widget header. Are we logged in and on the right page?")
-		return nil, errors.New(ErrFailedToParse)
+		return nil, errMissing(PageHome, selectorWidgetHeader)
+	}
+
+	attendanceList := attendanceWidgetHeader.Parent().Find(selectorAttendanceList)
+	if attendanceList.Length() == 0 {
+		klog.Warning("Failed to find the attendance list in the attendance widget.")
+		return nil, errMissing(PageHome, selectorAttendanceList)
 	}
 
 	attendance := make(models.AttendanceRecords, attendanceList.Length())
-	attendanceList.Each(func(i int, record *goquery.Selection) {
-		attended, held := func() (int, int) {
-			raw := record.Find("div.class-count span").Text()
-			sanitized := strings.Trim(raw, " \"")
-			divided := strings.Split(sanitized, "/")
-			if len(divided) != 2 {
-				klog.Warning("Attendance string has unexpected format!")
+	var formatErr error
+	attendanceList.EachWithBreak(func(i int, record *goquery.Selection) bool {
+		// The count is like "(12/20)", optionally quoted.
+		rawCount := record.Find(selectorAttendanceCount).Text()
+		attended, held, ok := func() (int, int, bool) {
+			rawAttended, rawHeld, found := strings.Cut(strings.Trim(rawCount, " \"()"), "/")
+			if !found {
+				return 0, 0, false
 			}
-
-			return parseToInt(divided[0]), parseToInt(divided[1])
+			attended, err1 := strconv.Atoi(strings.TrimSpace(rawAttended))
+			held, err2 := strconv.Atoi(strings.TrimSpace(rawHeld))
+			return attended, held, err1 == nil && err2 == nil
 		}()
+		if !ok {
+			klog.Warning("Attendance string has unexpected format!")
+			formatErr = errMalformed(PageHome, selectorAttendanceCount, rawCount)
+			return false
+		}
 
 		courseAttendance := models.AttendanceRecord{
 			Course: models.CourseRef{
 				Code: func() string {
-					raw := record.Find("span.sub-code").Text()
+					raw := record.Find(selectorAttendanceCourseCode).Text()
 					return strings.TrimSpace(raw)
 				}(),
 				Name: func() string {
-					rawInner := record.Find("span.lbl").Text()
-					spaceIndex := strings.IndexRune(rawInner, ' ')
-					return strings.TrimSpace(rawInner[spaceIndex:])
+					// The label is like "[CODE] Course Name"; we drop the code.
+					rawInner := record.Find(selectorAttendanceCourseName).Text()
+					if spaceIndex := strings.IndexRune(rawInner, ' '); spaceIndex != -1 {
+						rawInner = rawInner[spaceIndex:]
+					}
+					return strings.TrimSpace(rawInner)
 				}(),
 			},
 			Attendance: models.Attendance{
@@ -71,16 +101,11 @@ func Attendance(body io.Reader) (models.AttendanceRecords, error) {
 		}
 
 		attendance[i] = courseAttendance
+		return true
 	})
+	if formatErr != nil {
+		return nil, formatErr
+	}
 
 	return attendance, nil
 }
-
-// parseToInt parses an integer to a string, logs on failure.
-func parseToInt(raw string) int {
-	i, err := strconv.Atoi(raw)
-	if err != nil {
-		klog.Errorf("Failed to parse string to int: %s", err.Error())
-	}
-	return i
-}
@@ -1,6 +1,8 @@
 package parse_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/ditsuke/go-amizone/amizone/internal/mock"
@@ -22,6 +24,10 @@ func TestAttendance(t *testing.T) {
 			bodyFile: mock.HomePageLoggedIn,
 			attendanceMatcher: func(g *GomegaWithT, attendance *models.AttendanceRecords) {
 				g.Expect(len(*attendance)).To(Equal(8))
+				g.Expect((*attendance)[0]).To(Equal(models.AttendanceRecord{
+					Attendance: models.Attendance{ClassesHeld: 48, ClassesAttended: 46},
+					Course:     models.CourseRef{Code: "MATH242", Name: "Applied Mathematics-IV"},
+				}))
 			},
 			errorMatcher: func(g *GomegaWithT, err error) {
 				g.Expect(err).ToNot(HaveOccurred())
@@ -42,3 +48,24 @@ func TestAttendance(t *testing.T) {
 		})
 	}
 }
+
+func TestAttendance_Malformed(t *testing.T) {
+	const pageTpl = `<div><div class="widget-header">My Attendance</div><ul id="tasks"><li>` +
+		`<div class="class-count"><span>%s</span></div><span class="lbl"><span class="sub-code">CSE101</span>%s</span>` +
+		`</li></ul></div>`
+
+	t.Run("malformed count", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		_, err := parse.Attendance(strings.NewReader(fmt.Sprintf(pageTpl, "46", "CSE101 Programming")))
+		g.Expect(err).To(MatchError(parse.ErrFailedToParse))
+		g.Expect(err).To(MatchError(parse.ErrMalformedValue))
+	})
+
+	t.Run("course name without code", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		attendance, err := parse.Attendance(strings.NewReader(fmt.Sprintf(pageTpl, "(4/5)", "Programming")))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(attendance).To(HaveLen(1))
+		g.Expect(attendance[0].Attendance).To(Equal(models.Attendance{ClassesHeld: 5, ClassesAttended: 4}))
+	})
+}
@@ -0,0 +1,102 @@
+package parse_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ditsuke/go-amizone/amizone/internal/mock"
+	"github.com/ditsuke/go-amizone/amizone/internal/parse"
+)
+
+// The benchmarks in this file run the parsers over the testdata pages. Compare runs with benchstat, e.g.:
+//
+//	make bench BENCH_OUT=old.txt; <change things>; make bench BENCH_OUT=new.txt; benchstat old.txt new.txt
+
+// parserBenchmarks are the benchmarks of BenchmarkParse: each runs a parser over a testdata page.
+var parserBenchmarks = []struct {
+	name  string
+	file  mock.File
+	parse func(io.Reader) error
+}{
+	{"Attendance", mock.HomePageLoggedIn, func(r io.Reader) error { _, err := parse.Attendance(r); return err }},
+	{"ClassSchedule", mock.DiaryEventsJSON, func(r io.Reader) error { _, err := parse.ClassSchedule(r); return err }},
+	{"Courses", mock.CoursesPage, func(r io.Reader) error { _, err := parse.Courses(r); return err }},
+	{"CoursesSemWise", mock.CoursesPageSemWise, func(r io.Reader) error { _, err := parse.Courses(r); return err }},
+	{"ExaminationResult", mock.ExaminationResultPage, func(r io.Reader) error { _, err := parse.ExaminationResult(r); return err }},
+	{"ExaminationSchedule", mock.ExaminationSchedule, func(r io.Reader) error { _, err := parse.ExaminationSchedule(r); return err }},
+	{"FacultyFeedback", mock.FacultyPage, func(r io.Reader) error { _, err := parse.FacultyFeedback(r); return err }},
+	{"IsLoggedIn", mock.HomePageLoggedIn, func(r io.Reader) error { parse.IsLoggedIn(r); return nil }},
+	{"Profile", mock.IDCardPage, func(r io.Reader) error { _, err := parse.Profile(r); return err }},
+	{"Semesters", mock.CoursesPage, func(r io.Reader) error { _, err := parse.Semesters(r); return err }},
+	{"VerificationToken", mock.LoginPage, func(r io.Reader) error { _, err := parse.VerificationToken(r); return err }},
+	{"WifiMacInfo", mock.WifiPage, func(r io.Reader) error { _, err := parse.WifiMacInfo(r); return err }},
+}
+
+func BenchmarkParse(b *testing.B) {
+	for _, bm := range parserBenchmarks {
+		bm := bm
+		page := readFile(b, bm.file)
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(page)))
+			for i := 0; i < b.N; i++ {
+				if err := bm.parse(bytes.NewReader(page)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkCheck(b *testing.B) {
+	pages := map[string]mock.File{
+		parse.PageHome:                mock.HomePageLoggedIn,
+		parse.PageDiaryEvents:         mock.DiaryEventsJSON,
+		parse.PageCourses:             mock.CoursesPage,
+		parse.PageExaminationResult:   mock.ExaminationResultPage,
+		parse.PageExaminationSchedule: mock.ExaminationSchedule,
+		parse.PageFacultyFeedback:     mock.FacultyPage,
+		parse.PageIDCard:              mock.IDCardPage,
+		parse.PageWifiMacRegistration: mock.WifiPage,
+	}
+	for _, page := range parse.CheckedPages() {
+		page := page
+		file, ok := pages[page]
+		if !ok {
+			b.Fatalf("no testdata page to check %q with", page)
+		}
+		body := readFile(b, file)
+		b.Run(page, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(body)))
+			for i := 0; i < b.N; i++ {
+				if _, err := parse.Check(page, bytes.NewReader(body)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkCleanString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		parse.CleanString("  <b>Applied Mathematics-IV</b> &amp; Lab [MATH242] ", '[', ']')
+	}
+}
+
+// readFile reads file, failing the benchmark if it can't be read.
+func readFile(b *testing.B, file mock.File) []byte {
+	b.Helper()
+	f, err := file.Open()
+	if err != nil {
+		b.Fatalf("open %s: %s", file, err)
+	}
+	defer f.Close()
+	raw, err := io.ReadAll(f)
+	if err != nil {
+		b.Fatalf("read %s: %s", file, err)
+	}
+	return raw
+}
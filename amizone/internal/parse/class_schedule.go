@@ -19,7 +19,7 @@ const (
 func ClassSchedule(body io.Reader) (models.ClassSchedule, error) {
 	var diaryEvents models.AmizoneDiaryEvents
 	if err := json.NewDecoder(body).Decode(&diaryEvents); err != nil {
-		return nil, fmt.Errorf("JSON decode: %w", err)
+		return nil, &Error{Page: PageDiaryEvents, Err: fmt.Errorf("JSON decode: %w", err)}
 	}
 
 	var classSchedule models.ClassSchedule
@@ -32,7 +32,7 @@ func ClassSchedule(body io.Reader) (models.ClassSchedule, error) {
 		parseTime := func(timeStr string) time.Time {
 			t, err := time.Parse(scheduleJsonTimeFormat, timeStr)
 			if err != nil {
-				klog.Warning("Failed to parse time for course %s: %s", entry.CourseCode, err.Error())
+				klog.Warningf("Failed to parse time for course %s: %s", entry.CourseCode, err.Error())
 				return time.Unix(0, 0)
 			}
 			return t
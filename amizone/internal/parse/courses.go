@@ -1,7 +1,6 @@
 package parse
 
 import (
-	"errors"
 	"fmt"
 	"io"
 	"regexp"
@@ -12,12 +11,21 @@ import (
 	"k8s.io/klog/v2"
 )
 
-// Expose these data-title attributes, because they're used by the isCoursesPage function.
+// "data-title" attributes for the course tables
 const (
-	dtCourseCode       = "Course Code"
-	dtCourseAttendance = "Attendance"
+	dtCourseCode        = "Course Code"
+	dtCourseName        = "Course Name"
+	dtCourseType        = "Type"
+	dtCourseSyllabusDoc = "Course Syllabus"
+	dtCourseAttendance  = "Attendance"
+	dtCourseInternals   = "Internal Asses."
 )
 
+var coursesSchema = pageSchema{
+	rows:  selectorDataRows,
+	cells: dataCells(dtCourseCode, dtCourseName, dtCourseType, dtCourseAttendance),
+}
+
 // Courses parses the Amizone courses page.
 func Courses(body io.Reader) (models.Courses, error) {
 	// selectors
@@ -26,29 +34,19 @@ func Courses(body io.Reader) (models.Courses, error) {
 		selectorSecondaryCourseTable = "div:nth-child(2) > table:nth-child(1)"
 	)
 
-	// "data-title" attributes for the primary course table
-	const (
-		dtCode        = dtCourseCode
-		dtName        = "Course Name"
-		dtType        = "Type"
-		dtSyllabusDoc = "Course Syllabus"
-		dtAttendance  = dtCourseAttendance
-		dtInternals   = "Internal Asses."
-	)
-
 	dom, err := goquery.NewDocumentFromReader(body)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", ErrFailedToParseDOM, err)
+		return nil, errDOM(PageCourses, err)
 	}
 
 	if !IsLoggedInDOM(dom) {
-		return nil, errors.New(ErrNotLoggedIn)
+		return nil, errNotLoggedIn(PageCourses)
 	}
 
 	// We check for the course page first, but we can't rely on it alone because the "semester wise" course page does
 	// not come with breadcrumbs.
 	if !isCoursesPage(dom) {
-		return nil, errors.New(ErrFailedToParse)
+		return nil, errUnexpectedPage(PageCourses)
 	}
 
 	normDom := normalisePage(dom.Selection)
@@ -56,14 +54,14 @@ func Courses(body io.Reader) (models.Courses, error) {
 	courseTablePrimary := normDom.Find(selectorPrimaryCourseTable)
 	if matches := courseTablePrimary.Length(); matches != 1 {
 		klog.Warning("failed to find the main course table. selector matches:", matches)
-		return nil, errors.New(ErrFailedToParse)
+		return nil, errMissing(PageCourses, selectorPrimaryCourseTable)
 	}
 
 	// primary courses
 	primaryEntries := courseTablePrimary.Find(selectorDataRows)
 	if primaryEntries.Length() == 0 {
 		klog.Errorf("found no primary courses on the courses page")
-		return nil, errors.New(ErrFailedToParse)
+		return nil, errMissing(PageCourses, selectorPrimaryCourseTable+" "+selectorDataRows)
 	}
 
 	// secondary courses
@@ -77,12 +75,12 @@ func Courses(body io.Reader) (models.Courses, error) {
 	courseEntries.Each(func(i int, row *goquery.Selection) {
 		course := models.Course{
 			CourseRef: models.CourseRef{
-				Name: CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtName)).Text()),
-				Code: CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtCode)).Text()),
+				Name: CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtCourseName)).Text()),
+				Code: CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtCourseCode)).Text()),
 			},
-			Type: CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtType)).Text()),
+			Type: CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtCourseType)).Text()),
 			Attendance: func() models.Attendance {
-				raw := row.Find(fmt.Sprintf(selectorTplDataCell, dtAttendance)).Text()
+				raw := row.Find(fmt.Sprintf(selectorTplDataCell, dtCourseAttendance)).Text()
 				// go std regex doesn't have lookarounds :(
 				attendedStr := regexp.MustCompile(`\d{1,2}/`).FindString(raw)
 				attended, err1 := strconv.Atoi(CleanString(attendedStr, '/'))
@@ -98,7 +96,7 @@ func Courses(body io.Reader) (models.Courses, error) {
 				}
 			}(),
 			InternalMarks: func() models.Marks {
-				raw := row.Find(fmt.Sprintf(selectorTplDataCell, dtInternals)).Text()
+				raw := row.Find(fmt.Sprintf(selectorTplDataCell, dtCourseInternals)).Text()
 				gotStr := regexp.MustCompile(`\d{1,2}(\.\d{1,2})?[\[/]`).FindString(raw)
 				got, err1 := strconv.ParseFloat(CleanString(gotStr, '[', '/'), 32)
 				maxStr := regexp.MustCompile(`/\d{1,2}(\.\d{1,2})?`).FindString(raw)
@@ -113,7 +111,7 @@ func Courses(body io.Reader) (models.Courses, error) {
 					Have: float32(got),
 				}
 			}(),
-			SyllabusDoc: row.Find(fmt.Sprintf(selectorTplDataCell, dtSyllabusDoc)).Find("a").AttrOr("href", ""),
+			SyllabusDoc: row.Find(fmt.Sprintf(selectorTplDataCell, dtCourseSyllabusDoc)).Find("a").AttrOr("href", ""),
 		}
 		courses[i] = course
 	})
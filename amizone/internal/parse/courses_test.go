@@ -1,6 +1,7 @@
 package parse_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ditsuke/go-amizone/amizone/internal/mock"
@@ -45,7 +46,11 @@ func TestCourses(t *testing.T) {
 				g.Expect(courses).To(BeNil())
 			},
 			errMatcher: func(g *GomegaWithT, err error) {
-				g.Expect(err.Error()).To(ContainSubstring(parse.ErrFailedToParse))
+				g.Expect(err).To(MatchError(parse.ErrFailedToParse))
+				g.Expect(err).To(MatchError(parse.ErrNotLoggedIn))
+				var parseErr *parse.Error
+				g.Expect(errors.As(err, &parseErr)).To(BeTrue())
+				g.Expect(parseErr.Page).To(Equal(parse.PageCourses))
 			},
 		},
 	}
@@ -0,0 +1,84 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors. Every error returned by a parser in this package is an *Error, which matches ErrFailedToParse
+// through errors.Is, and wraps one of the more specific sentinels below.
+var (
+	ErrFailedToParse    = errors.New("failed to parse")
+	ErrFailedToParseDOM = errors.New("failed to parse DOM")
+	ErrNotLoggedIn      = errors.New("not logged in")
+	ErrUnexpectedPage   = errors.New("unexpected page")
+	ErrMissingElement   = errors.New("expected element not found")
+	ErrMalformedValue   = errors.New("malformed value")
+)
+
+// Page names used to identify the page that failed to parse in an *Error.
+const (
+	PageLogin               = "login"
+	PageHome                = "home"
+	PageDiaryEvents         = "diary events"
+	PageCourses             = "courses"
+	PageExaminationResult   = "examination result"
+	PageExaminationSchedule = "examination schedule"
+	PageFacultyFeedback     = "faculty feedback"
+	PageIDCard              = "id card"
+	PageWifiMacRegistration = "wifi mac registration"
+)
+
+// Error is the error type returned by the parsers in this package. It carries the name of the page being parsed
+// and, when the failure is down to the page's markup, the selector that failed to match.
+type Error struct {
+	Page     string
+	Selector string
+	Err      error
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("%s %s page", ErrFailedToParse, e.Page)
+	if e.Selector != "" {
+		msg += fmt.Sprintf(" (selector %q)", e.Selector)
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is makes every *Error match ErrFailedToParse.
+func (e *Error) Is(target error) bool {
+	return target == ErrFailedToParse
+}
+
+// errDOM returns an *Error for a page that could not be parsed into a DOM at all.
+func errDOM(page string, err error) *Error {
+	return &Error{Page: page, Err: fmt.Errorf("%w: %s", ErrFailedToParseDOM, err)}
+}
+
+// errNotLoggedIn returns an *Error for a page that turned out to be the login page.
+func errNotLoggedIn(page string) *Error {
+	return &Error{Page: page, Err: ErrNotLoggedIn}
+}
+
+// errUnexpectedPage returns an *Error for a page that is not the one the parser expects.
+func errUnexpectedPage(page string) *Error {
+	return &Error{Page: page, Err: ErrUnexpectedPage}
+}
+
+// errMissing returns an *Error for a page where an expected element could not be found through selector.
+func errMissing(page, selector string) *Error {
+	return &Error{Page: page, Selector: selector, Err: ErrMissingElement}
+}
+
+// errMalformed returns an *Error for a page where the value of an element found through selector is not in the
+// format expected.
+func errMalformed(page, selector, value string) *Error {
+	return &Error{Page: page, Selector: selector, Err: fmt.Errorf("%w: %q", ErrMalformedValue, value)}
+}
@@ -0,0 +1,55 @@
+package parse_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ditsuke/go-amizone/amizone/internal/parse"
+	. "github.com/onsi/gomega"
+)
+
+func TestError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      *parse.Error
+		expected string
+		matches  []error
+	}{
+		{
+			name:     "missing element",
+			err:      &parse.Error{Page: parse.PageHome, Selector: ".widget-header", Err: parse.ErrMissingElement},
+			expected: `failed to parse home page (selector ".widget-header"): expected element not found`,
+			matches:  []error{parse.ErrFailedToParse, parse.ErrMissingElement},
+		},
+		{
+			name:     "not logged in",
+			err:      &parse.Error{Page: parse.PageIDCard, Err: parse.ErrNotLoggedIn},
+			expected: "failed to parse id card page: not logged in",
+			matches:  []error{parse.ErrFailedToParse, parse.ErrNotLoggedIn},
+		},
+		{
+			name:     "malformed value",
+			err:      &parse.Error{Page: parse.PageHome, Selector: "div.class-count span", Err: fmt.Errorf("%w: %q", parse.ErrMalformedValue, "46")},
+			expected: `failed to parse home page (selector "div.class-count span"): malformed value: "46"`,
+			matches:  []error{parse.ErrFailedToParse, parse.ErrMalformedValue},
+		},
+		{
+			name:     "no cause",
+			err:      &parse.Error{Page: parse.PageCourses},
+			expected: "failed to parse courses page",
+			matches:  []error{parse.ErrFailedToParse},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(testCase.err.Error()).To(Equal(testCase.expected))
+			for _, target := range testCase.matches {
+				g.Expect(errors.Is(testCase.err, target)).To(BeTrue(), "expected error to match %q", target)
+			}
+			g.Expect(errors.Is(testCase.err, parse.ErrUnexpectedPage)).To(BeFalse())
+		})
+	}
+}
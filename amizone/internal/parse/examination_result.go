@@ -1,10 +1,10 @@
 package parse
 
 import (
-	"errors"
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
@@ -12,34 +12,41 @@ import (
 	"k8s.io/klog/v2"
 )
 
+const selectorResultTables = "div#no-more-tables"
+
+// "data-title" attributes for exams result entry cells
+const (
+	dtResultCode = "Course Code"
+	dtResultName = "Course Title"
+
+	dtResultMax  = "Max Total"
+	dtResultAcu  = "ACU"
+	dtResultGo   = "Go"
+	dtResultGp   = "GP"
+	dtResultCp   = "CP"
+	dtResultEcu  = "ECU"
+	dtResultDate = "PublishDate"
+
+	dtResultSem  = "Semester"
+	dtResultSGPA = "SGPA"
+	dtResultCGPA = "CGPA"
+)
+
+var examinationResultSchema = pageSchema{
+	elements: []string{selectorResultTables},
+	rows:     selectorDataRows,
+	cells: dataCells(
+		dtResultCode, dtResultName, dtResultMax, dtResultAcu, dtResultGo, dtResultGp, dtResultCp, dtResultEcu, dtResultDate,
+		dtResultSem, dtResultSGPA, dtResultCGPA,
+	),
+}
+
 // ExaminationResult attempts to parse exam result information from the Amizone Examination Results page
 // into a models.ExaminationResultRecords instance.
 func ExaminationResult(body io.Reader) (*models.ExamResultRecords, error) {
 	const (
-		resultTablesSelector = "div#no-more-tables"
-		coursesResultIndex   = 0
-		overallResultIndex   = 1
-	)
-
-	// "data-title" attributes for exams result entry cells
-	const (
-		dataCellSelectorTpl = "td[data-title='%s']"
-
-		dTitleCode = "Course Code"
-		dTitleName = "Course Title"
-
-		dTitleMax  = "Max Total"
-		dTitleAcu  = "ACU"
-		dTitleGo   = "Go"
-		dTitleGp   = "GP"
-		dTitleCp   = "CP"
-		dTitleEcu  = "ECU"
-		dTitleDate = "PublishDate"
-
-		dTitleSem  = "Semester"
-		dTitleSGPA = "SGPA"
-		dTitleCGPA = "CGPA"
-		dTitleBack = "Back Papers"
+		coursesResultIndex = 0
+		overallResultIndex = 1
 	)
 
 	const (
@@ -49,18 +56,18 @@ func ExaminationResult(body io.Reader) (*models.ExamResultRecords, error) {
 
 	dom, err := goquery.NewDocumentFromReader(body)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", ErrFailedToParseDOM, err)
+		return nil, errDOM(PageExaminationResult, err)
 	}
 
 	if !IsLoggedInDOM(dom) {
-		return nil, errors.New(ErrNotLoggedIn)
+		return nil, errNotLoggedIn(PageExaminationResult)
 	}
 
 	// Try to find the two tables to see if we are on the correct page
-	tables := dom.Find(resultTablesSelector).Children()
+	tables := dom.Find(selectorResultTables).Children()
 	if tables.Length() != 2 {
 		klog.Warning("Wrong number of tables detected in 'Examination Result'. Are we on the right page and logged in?")
-		return nil, errors.New(ErrFailedToParse)
+		return nil, errMissing(PageExaminationResult, selectorResultTables)
 	}
 
 	// Get the table body from the <div>
@@ -70,40 +77,48 @@ func ExaminationResult(body io.Reader) (*models.ExamResultRecords, error) {
 	// Gets every <tr> from the table
 	overallResultEntries := overallResultTable.Children()
 	overallResult := make([]models.OverallResult, overallResultEntries.Length())
-	overallResultEntries.Each(func(i int, row *goquery.Selection) {
+	var formatErr error
+	overallResultEntries.EachWithBreak(func(i int, row *goquery.Selection) bool {
+		cells := resultCells{row: row}
 		result := models.OverallResult{
 			Semester: models.Semester{
-				Name: CleanString(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleSem)).Text()),
-				Ref:  CleanString(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleSem)).Text()),
+				Name: CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtResultSem)).Text()),
+				Ref:  CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtResultSem)).Text()),
 			},
-			SemesterGradePointAverage:   float32(parseToFloat(CleanString(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleSGPA)).Text()))),
-			CumulativeGradePointAverage: float32(parseToFloat((CleanString(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleCGPA)).Text())))),
+			SemesterGradePointAverage:   cells.float(dtResultSGPA),
+			CumulativeGradePointAverage: cells.float(dtResultCGPA),
 		}
 		overallResult[i] = result
+		formatErr = cells.err
+		return formatErr == nil
 	})
+	if formatErr != nil {
+		return nil, formatErr
+	}
 
 	// Gets every <tr> from the table
 	courseWiseResultEntries := courseWiseResultTable.Children()
 	courseWiseResult := make([]models.ExamResultRecord, courseWiseResultEntries.Length())
-	courseWiseResultEntries.Each(func(i int, row *goquery.Selection) {
+	courseWiseResultEntries.EachWithBreak(func(i int, row *goquery.Selection) bool {
+		cells := resultCells{row: row}
 		result := models.ExamResultRecord{
 			Course: models.CourseRef{
-				Code: CleanString(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleCode)).Text()),
-				Name: CleanString(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleName)).Text()),
+				Code: CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtResultCode)).Text()),
+				Name: CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtResultName)).Text()),
 			},
 			CourseResult: models.CourseResult{
 				Score: models.Score{
-					Max:        parseToInt(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleMax)).Text()),
-					Grade:      CleanString(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleGo)).Text()),
-					GradePoint: parseToInt(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleGp)).Text()),
+					Max:        cells.int(dtResultMax),
+					Grade:      CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtResultGo)).Text()),
+					GradePoint: cells.int(dtResultGp),
 				},
 				Credits: models.Credits{
-					Acquired:  parseToInt(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleAcu)).Text()),
-					Points:    parseToInt(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleCp)).Text()),
-					Effective: parseToInt(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleEcu)).Text()),
+					Acquired:  cells.int(dtResultAcu),
+					Points:    cells.int(dtResultCp),
+					Effective: cells.int(dtResultEcu),
 				},
 				PublishDate: func() time.Time {
-					parsedTime, nil := time.Parse(tableDateFormat, row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleDate)).Text())
+					parsedTime, err := time.Parse(tableDateFormat, row.Find(fmt.Sprintf(selectorTplDataCell, dtResultDate)).Text())
 					if err != nil {
 						klog.Warningf("Failed to parse publish date: %s", err.Error())
 					}
@@ -112,7 +127,12 @@ func ExaminationResult(body io.Reader) (*models.ExamResultRecords, error) {
 			},
 		}
 		courseWiseResult[i] = result
+		formatErr = cells.err
+		return formatErr == nil
 	})
+	if formatErr != nil {
+		return nil, formatErr
+	}
 
 	resultRecords := models.ExamResultRecords{
 		CourseWise: courseWiseResult,
@@ -122,14 +142,40 @@ func ExaminationResult(body io.Reader) (*models.ExamResultRecords, error) {
 	return &resultRecords, nil
 }
 
-// parseToFloat parses an integer to a string, logs on failure.
-func parseToFloat(raw string) float64 {
+// resultCells reads the numeric data cells of a row of the examination result tables, keeping the first
+// malformed value as an *Error. Empty cells are read as zero.
+type resultCells struct {
+	row *goquery.Selection
+	err error
+}
+
+func (c *resultCells) int(title string) int {
+	raw := strings.TrimSpace(c.row.Find(fmt.Sprintf(selectorTplDataCell, title)).Text())
 	if raw == "" {
-		return 0.0
+		return 0
 	}
-	i, err := strconv.ParseFloat(raw, 32)
+	i, err := strconv.Atoi(raw)
 	if err != nil {
-		klog.Errorf("Failed to parse string to float: %s", err.Error())
+		c.fail(title, raw)
 	}
 	return i
 }
+
+func (c *resultCells) float(title string) float32 {
+	raw := strings.TrimSpace(c.row.Find(fmt.Sprintf(selectorTplDataCell, title)).Text())
+	if raw == "" {
+		return 0
+	}
+	f, err := strconv.ParseFloat(raw, 32)
+	if err != nil {
+		c.fail(title, raw)
+	}
+	return float32(f)
+}
+
+// fail records raw, the value of the cell titled title, as malformed, unless a value was already.
+func (c *resultCells) fail(title, raw string) {
+	if c.err == nil {
+		c.err = errMalformed(PageExaminationResult, fmt.Sprintf(selectorTplDataCell, title), raw)
+	}
+}
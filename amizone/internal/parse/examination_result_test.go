@@ -1,6 +1,9 @@
 package parse_test
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -53,3 +56,38 @@ func TestExaminationResult(t *testing.T) {
 		})
 	}
 }
+
+func TestExaminationResult_Malformed(t *testing.T) {
+	const pageTpl = `<div id="no-more-tables">` +
+		`<table><tbody><tr><td data-title="Course Code">CSE101</td><td data-title="Max Total">%s</td>` +
+		`<td data-title="GP">8</td><td data-title="ACU">4</td><td data-title="CP">32</td><td data-title="ECU"></td></tr></tbody></table>` +
+		`<table><tbody><tr><td data-title="Semester">1</td><td data-title="SGPA">%s</td><td data-title="CGPA">8.5</td></tr></tbody></table>` +
+		`</div>`
+
+	t.Run("well-formed numbers", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		result, err := parse.ExaminationResult(strings.NewReader(fmt.Sprintf(pageTpl, " 100 ", "8.25")))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.CourseWise[0].Score.Max).To(Equal(100))
+		g.Expect(result.CourseWise[0].Credits.Effective).To(BeZero(), "empty cells should be read as zero")
+		g.Expect(result.Overall[0].SemesterGradePointAverage).To(BeNumerically("~", 8.25))
+	})
+
+	t.Run("malformed integer", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		result, err := parse.ExaminationResult(strings.NewReader(fmt.Sprintf(pageTpl, "1OO", "8.25")))
+		g.Expect(result).To(BeNil())
+		g.Expect(err).To(MatchError(parse.ErrFailedToParse))
+		g.Expect(err).To(MatchError(parse.ErrMalformedValue))
+		var parseErr *parse.Error
+		g.Expect(errors.As(err, &parseErr)).To(BeTrue())
+		g.Expect(parseErr.Page).To(Equal(parse.PageExaminationResult))
+		g.Expect(parseErr.Selector).To(Equal("td[data-title='Max Total']"))
+	})
+
+	t.Run("malformed float", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		_, err := parse.ExaminationResult(strings.NewReader(fmt.Sprintf(pageTpl, "100", "N/A")))
+		g.Expect(err).To(MatchError(parse.ErrMalformedValue))
+	})
+}
@@ -1,7 +1,6 @@
 package parse
 
 import (
-	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -18,25 +17,35 @@ import (
 
 const ExamTitleUnknown = "Unknown Exam"
 
-// ExaminationSchedule attempts to parse a page into a models.ExaminationSchedule model.
-// This function expects the Amizone "Examination Schedule" page, parsable into an HTML document.
-func ExaminationSchedule(body io.Reader) (*models.ExaminationSchedule, error) {
-	const (
-		breadcrumbsSelector    = "#breadcrumbs > ul.breadcrumb > li.active"
-		scheduleBreadcrumbText = "Examination Schedule"
-	)
+const (
+	selectorScheduleBreadcrumb = "#breadcrumbs > ul.breadcrumb > li.active"
+	scheduleBreadcrumbText     = "Examination Schedule"
+	selectorScheduleTable      = "table.table"
+	selectorScheduleTitle      = "div.page-header h1"
+)
 
-	// "data-title" attributes for exams table entry cells
-	const (
-		dataCellSelectorTpl = "td[data-title='%s']"
+// "data-title" attributes for exams table entry cells
+const (
+	dtExamCode = "Course Code"
+	dtExamName = "Course Title"
+	dtExamDate = "Exam Date"
+	dtExamTime = "Time"
+	dtExamType = "Paper Type"
+)
 
-		dTitleCode = "Course Code"
-		dTitleName = "Course Title"
-		dTitleDate = "Exam Date"
-		dTitleTime = "Time"
-		dTitleType = "Paper Type"
-	)
+var examinationScheduleSchema = pageSchema{
+	elements: []string{
+		fmt.Sprintf("%s:contains('%s')", selectorScheduleBreadcrumb, scheduleBreadcrumbText),
+		selectorScheduleTable,
+		selectorScheduleTitle,
+	},
+	rows:  selectorScheduleTable + " " + selectorDataRows,
+	cells: dataCells(dtExamCode, dtExamName, dtExamDate, dtExamTime, dtExamType),
+}
 
+// ExaminationSchedule attempts to parse a page into a models.ExaminationSchedule model.
+// This function expects the Amizone "Examination Schedule" page, parsable into an HTML document.
+func ExaminationSchedule(body io.Reader) (*models.ExaminationSchedule, error) {
 	const (
 		// format for time.Parse() after appending date and time from the table
 		tableTimeFormat = "02/01/2006 15:04"
@@ -44,42 +53,42 @@ func ExaminationSchedule(body io.Reader) (*models.ExaminationSchedule, error) {
 
 	dom, err := goquery.NewDocumentFromReader(body)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", ErrFailedToParseDOM, err)
+		return nil, errDOM(PageExaminationSchedule, err)
 	}
 
 	if !IsLoggedInDOM(dom) {
-		return nil, errors.New(ErrNotLoggedIn)
+		return nil, errNotLoggedIn(PageExaminationSchedule)
 	}
 
 	// Try to find the "Examination Schedule" breadcrumb to determine if we're on the right page.
-	if scheduleBreadcrumb := dom.Find(breadcrumbsSelector).
+	if scheduleBreadcrumb := dom.Find(selectorScheduleBreadcrumb).
 		Filter(fmt.Sprintf(":contains('%s')", scheduleBreadcrumbText)); scheduleBreadcrumb.Length() == 0 {
 		klog.Warning("Failed to find the 'Examination Schedule' breadcrumb. Are we on the right page and logged in?")
-		return nil, errors.New(ErrFailedToParse)
+		return nil, errUnexpectedPage(PageExaminationSchedule)
 	}
 
 	// Attempt to get the examination table.
 	// @todo: Need tests with valid page that doesn't have exams information.
-	scheduleTable := dom.Find("table.table")
+	scheduleTable := dom.Find(selectorScheduleTable)
 	if scheduleTable.Length() == 0 {
 		klog.Warning("Failed to find the examination exams table. What's up?")
-		return nil, errors.New(ErrFailedToParse)
+		return nil, errMissing(PageExaminationSchedule, selectorScheduleTable)
 	}
 
 	// Attempt to get the examination exams rows.
-	scheduleEntries := scheduleTable.Find("tbody > tr")
+	scheduleEntries := scheduleTable.Find(selectorDataRows)
 	exams := make([]models.ScheduledExam, scheduleEntries.Length())
 
 	// Iterate through exams rows to parse entries
 	scheduleEntries.Each(func(i int, row *goquery.Selection) {
 		exam := models.ScheduledExam{
 			Course: models.CourseRef{
-				Code: CleanString(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleCode)).Text()),
-				Name: CleanString(row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleName)).Text()),
+				Code: CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtExamCode)).Text()),
+				Name: CleanString(row.Find(fmt.Sprintf(selectorTplDataCell, dtExamName)).Text()),
 			},
 			Time: func() time.Time {
-				rawDate := row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleDate)).Text()
-				rawTime := row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleTime)).Text()
+				rawDate := row.Find(fmt.Sprintf(selectorTplDataCell, dtExamDate)).Text()
+				rawTime := row.Find(fmt.Sprintf(selectorTplDataCell, dtExamTime)).Text()
 				parsedTime, err := time.Parse(tableTimeFormat, fmt.Sprintf("%s %s", rawDate, rawTime))
 				if err != nil {
 					klog.Warningf("Failed to parse exam time: %s", err.Error())
@@ -87,7 +96,7 @@ func ExaminationSchedule(body io.Reader) (*models.ExaminationSchedule, error) {
 				return parsedTime
 			}(),
 			Mode: func() string {
-				raw := row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleType)).Find("b").First().Text()
+				raw := row.Find(fmt.Sprintf(selectorTplDataCell, dtExamType)).Find("b").First().Text()
 				if split := lo.Slice(strings.Split(raw, ":"), 1, 2); len(split) != 0 {
 					return CleanString(split[0])
 				}
@@ -95,7 +104,7 @@ func ExaminationSchedule(body io.Reader) (*models.ExaminationSchedule, error) {
 				return strings.TrimSpace(raw)
 			}(),
 			Location: func() string {
-				liveInfo := row.Find(fmt.Sprintf(dataCellSelectorTpl, dTitleType)).Find("b[style='color:red']")
+				liveInfo := row.Find(fmt.Sprintf(selectorTplDataCell, dtExamType)).Find("b[style='color:red']")
 				liveInfo.Find("br").ReplaceWithHtml("\n")
 				raw := CleanString(liveInfo.Text())
 				if raw == "" {
@@ -113,7 +122,7 @@ func ExaminationSchedule(body io.Reader) (*models.ExaminationSchedule, error) {
 
 	// Attempt to get the examination title.
 	title := func() string {
-		raw := dom.Find("div.page-header h1").Text()
+		raw := dom.Find(selectorScheduleTitle).Text()
 		if raw != "" {
 			sanitised := strings.TrimSpace(raw)
 			// The title is usually like "EXAM TITLE ALL CAPS"
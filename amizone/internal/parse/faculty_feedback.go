@@ -1,8 +1,6 @@
 package parse
 
 import (
-	"errors"
-	"fmt"
 	"io"
 	"net/url"
 
@@ -10,6 +8,13 @@ import (
 	"github.com/ditsuke/go-amizone/amizone/models"
 )
 
+const selectorFeedbackLinkIcon = "i[title='Please click here to give faculty feedback']"
+
+// facultyFeedbackSchema doesn't expect feedback links, as there are none once feedback has been submitted.
+var facultyFeedbackSchema = pageSchema{
+	elements: []string{selectorActiveBreadcrumb, selectorVerificationToken},
+}
+
 func isFacultyPage(dom *goquery.Document) bool {
 	const FacultyPageBreadcrumb = "My Faculty"
 	return CleanString(dom.Find(selectorActiveBreadcrumb).Text()) == FacultyPageBreadcrumb
@@ -18,19 +23,19 @@ func isFacultyPage(dom *goquery.Document) bool {
 func FacultyFeedback(body io.Reader) (models.FacultyFeedbackSpecs, error) {
 	dom, err := goquery.NewDocumentFromReader(body)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %s", ErrFailedToParseDOM, err)
+		return nil, errDOM(PageFacultyFeedback, err)
 	}
 
 	if !IsLoggedInDOM(dom) {
-		return nil, errors.New(ErrNotLoggedIn)
+		return nil, errNotLoggedIn(PageFacultyFeedback)
 	}
 
 	if !isFacultyPage(dom) {
-		return nil, fmt.Errorf("%s: Not Faculty Feedback Page", ErrFailedToParse)
+		return nil, errUnexpectedPage(PageFacultyFeedback)
 	}
 
 	specs := make(models.FacultyFeedbackSpecs, 0)
-	dom.Find("i[title='Please click here to give faculty feedback']").Each(func(_ int, opt *goquery.Selection) {
+	dom.Find(selectorFeedbackLinkIcon).Each(func(_ int, opt *goquery.Selection) {
 		parentAnchor := opt.Parent()
 		if parentAnchor.Length() == 0 {
 			// log
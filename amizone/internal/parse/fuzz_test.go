@@ -0,0 +1,170 @@
+package parse_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/ditsuke/go-amizone/amizone/internal/mock"
+	"github.com/ditsuke/go-amizone/amizone/internal/parse"
+)
+
+// The fuzz targets in this file check that parsers never panic, whatever Amizone sends, and that the errors they
+// return are all *parse.Error. They're seeded with the testdata corpus, and run as regular tests on their seeds;
+// to fuzz one, run e.g.:
+//
+//	go test ./amizone/internal/parse -run '^$' -fuzz '^FuzzAttendance$' -fuzztime 1m
+
+// fuzzSeeds are seeds shared by all fuzz targets: degenerate documents and fragments of Amizone's markup.
+var fuzzSeeds = []string{
+	"",
+	"<html>",
+	"[]",
+	"null",
+	`<div class="widget-header">My Attendance</div><ul id="tasks"><li><div class="class-count"><span>46</span></div><span class="lbl">Name</span></li></ul>`,
+	`<table><tbody><tr><td data-title="Course Code">CSE101</td><td data-title="Attendance">1/</td><td data-title="Internal Asses.">[/]</td></tr></tbody></table>`,
+	`[{"sType": "C", "start": "not a time"}]`,
+}
+
+// addSeeds adds the files passed, the login page every page turns into when the session expires, and
+// fuzzSeeds to the seed corpus of f.
+func addSeeds(f *testing.F, files ...mock.File) {
+	for _, file := range append(files, mock.LoginPage) {
+		fd, err := file.Open()
+		if err != nil {
+			f.Fatalf("open %s: %s", file, err)
+		}
+		raw, err := io.ReadAll(fd)
+		if err != nil {
+			f.Fatalf("read %s: %s", file, err)
+		}
+		f.Add(raw)
+	}
+	for _, seed := range fuzzSeeds {
+		f.Add([]byte(seed))
+	}
+}
+
+// checkParseError fails the test if err isn't nil or a *parse.Error.
+func checkParseError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		return
+	}
+	var parseErr *parse.Error
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("error is not a *parse.Error: %s", err)
+	}
+	if !errors.Is(err, parse.ErrFailedToParse) {
+		t.Fatalf("error doesn't match ErrFailedToParse: %s", err)
+	}
+}
+
+func FuzzAttendance(f *testing.F) {
+	addSeeds(f, mock.HomePageLoggedIn)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := parse.Attendance(bytes.NewReader(body))
+		checkParseError(t, err)
+	})
+}
+
+func FuzzClassSchedule(f *testing.F) {
+	addSeeds(f, mock.DiaryEventsJSON, mock.DiaryEventsSmallJSON, mock.DiaryEventsNone)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := parse.ClassSchedule(bytes.NewReader(body))
+		checkParseError(t, err)
+	})
+}
+
+func FuzzCourses(f *testing.F) {
+	addSeeds(f, mock.CoursesPage, mock.CoursesPageSemWise)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := parse.Courses(bytes.NewReader(body))
+		checkParseError(t, err)
+	})
+}
+
+func FuzzExaminationResult(f *testing.F) {
+	addSeeds(f, mock.ExaminationResultPage)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := parse.ExaminationResult(bytes.NewReader(body))
+		checkParseError(t, err)
+	})
+}
+
+func FuzzExaminationSchedule(f *testing.F) {
+	addSeeds(f, mock.ExaminationSchedule, mock.ExaminationScheduleWithLocation)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := parse.ExaminationSchedule(bytes.NewReader(body))
+		checkParseError(t, err)
+	})
+}
+
+func FuzzFacultyFeedback(f *testing.F) {
+	addSeeds(f, mock.FacultyPage)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := parse.FacultyFeedback(bytes.NewReader(body))
+		checkParseError(t, err)
+	})
+}
+
+func FuzzIsLoggedIn(f *testing.F) {
+	addSeeds(f, mock.HomePageLoggedIn)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		parse.IsLoggedIn(bytes.NewReader(body))
+	})
+}
+
+func FuzzProfile(f *testing.F) {
+	addSeeds(f, mock.IDCardPage)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := parse.Profile(bytes.NewReader(body))
+		checkParseError(t, err)
+	})
+}
+
+func FuzzSemesters(f *testing.F) {
+	addSeeds(f, mock.CoursesPage)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := parse.Semesters(bytes.NewReader(body))
+		checkParseError(t, err)
+	})
+}
+
+func FuzzVerificationToken(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := parse.VerificationToken(bytes.NewReader(body))
+		checkParseError(t, err)
+	})
+}
+
+func FuzzWifiMacInfo(f *testing.F) {
+	addSeeds(f, mock.WifiPage, mock.WifiPageOneSlotPopulated)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := parse.WifiMacInfo(bytes.NewReader(body))
+		checkParseError(t, err)
+	})
+}
+
+func FuzzCheck(f *testing.F) {
+	addSeeds(f, mock.HomePageLoggedIn, mock.CoursesPage, mock.IDCardPage, mock.DiaryEventsJSON)
+	pages := parse.CheckedPages()
+	f.Fuzz(func(t *testing.T, body []byte) {
+		for _, page := range pages {
+			_, err := parse.Check(page, bytes.NewReader(body))
+			checkParseError(t, err)
+		}
+	})
+}
+
+func FuzzCleanString(f *testing.F) {
+	for _, seed := range []string{"", "  A&amp;B  ", `&`, "<b>Fac Name</b>", "\"quoted\"", "\\u00"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		parse.CleanString(s, '/', '[')
+		parse.UnescapeUnicode(s)
+	})
+}
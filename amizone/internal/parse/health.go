@@ -0,0 +1,186 @@
+package parse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+// pageSchema describes the markup a parser expects of a page, so pages can be checked for changes that would
+// break the parser, or make it return zero values, before users notice.
+type pageSchema struct {
+	// elements are the selectors of elements the page must have.
+	elements []string
+	// rows is the selector of the rows of the table or list the parser reads, if any.
+	rows string
+	// cells are the cells the parser reads from rows.
+	cells []cellSchema
+}
+
+// cellSchema describes a cell of the rows of a pageSchema.
+type cellSchema struct {
+	selector string
+	// column is the "data-title" of the cell, for table cells.
+	column string
+	// attr is the attribute the value of the cell is read from. Empty means the text of the cell.
+	attr string
+}
+
+// dataCells returns the cellSchema for table cells with "data-title" columns.
+func dataCells(columns ...string) []cellSchema {
+	cells := make([]cellSchema, len(columns))
+	for i, column := range columns {
+		cells[i] = cellSchema{selector: fmt.Sprintf(selectorTplDataCell, column), column: column}
+	}
+	return cells
+}
+
+// schemas are the schemas of the pages Check checks, by page.
+var schemas = map[string]pageSchema{
+	PageHome:                homeSchema,
+	PageCourses:             coursesSchema,
+	PageExaminationResult:   examinationResultSchema,
+	PageExaminationSchedule: examinationScheduleSchema,
+	PageIDCard:              idCardSchema,
+	PageWifiMacRegistration: wifiMacRegistrationSchema,
+	PageFacultyFeedback:     facultyFeedbackSchema,
+}
+
+// CheckedPages returns the pages Check has checks for, sorted.
+func CheckedPages() []string {
+	pages := []string{PageDiaryEvents}
+	for page := range schemas {
+		pages = append(pages, page)
+	}
+	sort.Strings(pages)
+	return pages
+}
+
+// Check checks a page, one of the Page constants, against what its parser expects of it, returning a finding
+// for each expected element or column that is missing or never has a value. Pages without checks have no
+// findings. Pages that can't be checked at all, like those that turn out to be the login page, fail with the
+// errors their parser would.
+func Check(page string, body io.Reader) ([]models.ParserFinding, error) {
+	if page == PageDiaryEvents {
+		return checkDiaryEvents(body)
+	}
+	schema, ok := schemas[page]
+	if !ok {
+		return nil, nil
+	}
+
+	dom, err := goquery.NewDocumentFromReader(body)
+	if err != nil {
+		return nil, errDOM(page, err)
+	}
+	if !IsLoggedInDOM(dom) {
+		return nil, errNotLoggedIn(page)
+	}
+
+	var findings []models.ParserFinding
+	for _, selector := range schema.elements {
+		if dom.Find(selector).Length() == 0 {
+			findings = append(findings, finding(selector, "", models.ProblemMissing))
+		}
+	}
+	if schema.rows == "" {
+		return findings, nil
+	}
+
+	rows := dom.Find(schema.rows)
+	if rows.Length() == 0 {
+		return append(findings, finding(schema.rows, "", models.ProblemMissing)), nil
+	}
+	for _, cell := range schema.cells {
+		found, hasValue := false, false
+		rows.Each(func(_ int, row *goquery.Selection) {
+			match := row.Find(cell.selector)
+			if match.Length() == 0 {
+				return
+			}
+			found = true
+			value := match.Text()
+			if cell.attr != "" {
+				value = match.AttrOr(cell.attr, "")
+			}
+			hasValue = hasValue || strings.TrimSpace(value) != ""
+		})
+		switch {
+		case !found:
+			findings = append(findings, finding(cell.selector, cell.column, models.ProblemMissing))
+		case !hasValue:
+			findings = append(findings, finding(cell.selector, cell.column, models.ProblemEmpty))
+		}
+	}
+	return findings, nil
+}
+
+// diaryEventKeys are the keys the class schedule parser reads from the class entries of the diary events
+// endpoint, and whether they must have a value.
+var diaryEventKeys = []struct {
+	key      string
+	required bool
+}{
+	{"sType", true},
+	{"title", true},
+	{"CourseCode", true},
+	{"start", true},
+	{"end", true},
+	{"FacultyName", false},
+	{"RoomNo", false},
+	{"AttndColor", false},
+}
+
+// checkDiaryEvents checks the response of the diary events endpoint, which is JSON rather than a page.
+func checkDiaryEvents(body io.Reader) ([]models.ParserFinding, error) {
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return nil, &Error{Page: PageDiaryEvents, Err: err}
+	}
+	var events []map[string]any
+	if err := json.Unmarshal(raw, &events); err != nil {
+		// Expired sessions get the login page rather than JSON, which says nothing about the endpoint.
+		if !IsLoggedIn(bytes.NewReader(raw)) {
+			return nil, errNotLoggedIn(PageDiaryEvents)
+		}
+		return nil, &Error{Page: PageDiaryEvents, Err: fmt.Errorf("JSON decode: %w", err)}
+	}
+
+	var classes []map[string]any
+	for _, event := range events {
+		if event["sType"] == "C" {
+			classes = append(classes, event)
+		}
+	}
+	if len(classes) == 0 {
+		return nil, nil
+	}
+
+	var findings []models.ParserFinding
+	for _, k := range diaryEventKeys {
+		found, hasValue := false, false
+		for _, class := range classes {
+			value, ok := class[k.key]
+			found = found || ok
+			hasValue = hasValue || (ok && value != nil && value != "")
+		}
+		switch {
+		case !found:
+			findings = append(findings, finding(k.key, "", models.ProblemMissing))
+		case k.required && !hasValue:
+			findings = append(findings, finding(k.key, "", models.ProblemEmpty))
+		}
+	}
+	return findings, nil
+}
+
+func finding(selector, column, problem string) models.ParserFinding {
+	return models.ParserFinding{Selector: selector, Column: column, Problem: problem, Count: 1}
+}
@@ -0,0 +1,124 @@
+package parse_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone/internal/mock"
+	"github.com/ditsuke/go-amizone/amizone/internal/parse"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+func TestCheck(t *testing.T) {
+	readFile := func(g *GomegaWithT, file mock.File) string {
+		f, err := file.Open()
+		g.Expect(err).ToNot(HaveOccurred())
+		raw, err := io.ReadAll(f)
+		g.Expect(err).ToNot(HaveOccurred())
+		return string(raw)
+	}
+
+	t.Run("fixtures are healthy", func(t *testing.T) {
+		for page, file := range map[string]mock.File{
+			parse.PageHome:                mock.HomePageLoggedIn,
+			parse.PageCourses:             mock.CoursesPage,
+			parse.PageExaminationResult:   mock.ExaminationResultPage,
+			parse.PageExaminationSchedule: mock.ExaminationSchedule,
+			parse.PageIDCard:              mock.IDCardPage,
+			parse.PageWifiMacRegistration: mock.WifiPage,
+			parse.PageFacultyFeedback:     mock.FacultyPage,
+			parse.PageDiaryEvents:         mock.DiaryEventsJSON,
+		} {
+			t.Run(page, func(t *testing.T) {
+				g := NewGomegaWithT(t)
+				findings, err := parse.Check(page, strings.NewReader(readFile(g, file)))
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(findings).To(BeEmpty())
+			})
+		}
+	})
+
+	testCases := []struct {
+		name     string
+		page     string
+		file     mock.File
+		mutate   func(body string) string
+		findings []models.ParserFinding
+	}{
+		{
+			name: "renamed column",
+			page: parse.PageCourses,
+			file: mock.CoursesPage,
+			mutate: func(body string) string {
+				return strings.ReplaceAll(body, `data-title="Course Name"`, `data-title="Subject"`)
+			},
+			findings: []models.ParserFinding{
+				{Selector: "td[data-title='Course Name']", Column: "Course Name", Problem: models.ProblemMissing, Count: 1},
+			},
+		},
+		{
+			name: "renamed attendance class",
+			page: parse.PageHome,
+			file: mock.HomePageLoggedIn,
+			mutate: func(body string) string {
+				return strings.ReplaceAll(body, `class-count"`, `attendance-count"`)
+			},
+			findings: []models.ParserFinding{
+				{Selector: "div.class-count span", Problem: models.ProblemMissing, Count: 1},
+			},
+		},
+		{
+			name: "missing ID card",
+			page: parse.PageIDCard,
+			file: mock.IDCardPage,
+			mutate: func(body string) string {
+				return strings.ReplaceAll(body, `lblInfoIDCardBack1`, `lblInfoBack`)
+			},
+			findings: []models.ParserFinding{
+				{Selector: "#lblInfoIDCardBack1", Problem: models.ProblemMissing, Count: 1},
+			},
+		},
+		{
+			name: "empty diary event key",
+			page: parse.PageDiaryEvents,
+			file: mock.DiaryEventsJSON,
+			mutate: func(body string) string {
+				return `[{"sType": "C", "title": "", "CourseCode": "CSE101", "start": "2022/09/01 09:15:00 AM", "end": "2022/09/01 10:10:00 AM"}]`
+			},
+			findings: []models.ParserFinding{
+				{Selector: "title", Problem: models.ProblemEmpty, Count: 1},
+				{Selector: "FacultyName", Problem: models.ProblemMissing, Count: 1},
+				{Selector: "RoomNo", Problem: models.ProblemMissing, Count: 1},
+				{Selector: "AttndColor", Problem: models.ProblemMissing, Count: 1},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			body := testCase.mutate(readFile(g, testCase.file))
+			findings, err := parse.Check(testCase.page, strings.NewReader(body))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(findings).To(Equal(testCase.findings))
+		})
+	}
+
+	t.Run("login page", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		_, err := parse.Check(parse.PageHome, strings.NewReader(readFile(g, mock.LoginPage)))
+		g.Expect(err).To(MatchError(parse.ErrNotLoggedIn))
+		_, err = parse.Check(parse.PageDiaryEvents, strings.NewReader(readFile(g, mock.LoginPage)))
+		g.Expect(err).To(MatchError(parse.ErrNotLoggedIn), "the diary events endpoint serves the login page too")
+	})
+
+	t.Run("diary events that aren't JSON", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		_, err := parse.Check(parse.PageDiaryEvents, strings.NewReader("<html>Server Error</html>"))
+		g.Expect(err).To(MatchError(parse.ErrFailedToParse))
+		g.Expect(err).ToNot(MatchError(parse.ErrNotLoggedIn))
+	})
+}
@@ -8,13 +8,6 @@ import (
 	"github.com/microcosm-cc/bluemonday"
 )
 
-// Errors
-const (
-	ErrFailedToParse    = "failed to parse"
-	ErrFailedToParseDOM = ErrFailedToParse + " DOM"
-	ErrNotLoggedIn      = ErrFailedToParse + ": not logged in"
-)
-
 // Shared selectors
 const (
 	selectorActiveBreadcrumb = "ul.breadcrumb li.active"
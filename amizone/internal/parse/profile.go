@@ -1,8 +1,6 @@
 package parse
 
 import (
-	"errors"
-	"fmt"
 	"io"
 	"regexp"
 	"strings"
@@ -13,26 +11,30 @@ import (
 	"k8s.io/klog/v2"
 )
 
+const (
+	selectorCardFront = "#lblNameIDCardFront1"
+	selectorCardBack  = "#lblInfoIDCardBack1"
+	selectorHeadshot  = "img#ImgPhotoIDCardFront1"
+)
+
+var idCardSchema = pageSchema{
+	elements: []string{selectorCardFront, selectorCardBack, selectorHeadshot + "[src]"},
+}
+
 func Profile(body io.Reader) (*models.Profile, error) {
 	dom, err := goquery.NewDocumentFromReader(body)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %s", ErrFailedToParseDOM, err)
+		return nil, errDOM(PageIDCard, err)
 	}
 
 	if !IsLoggedInDOM(dom) {
-		return nil, errors.New(ErrNotLoggedIn)
+		return nil, errNotLoggedIn(PageIDCard)
 	}
 
 	if !isIDCardPage(dom) {
-		return nil, fmt.Errorf("%s: Not ID Card Page", ErrFailedToParse)
+		return nil, errUnexpectedPage(PageIDCard)
 	}
 
-	const (
-		selectorCardFront = "#lblNameIDCardFront1"
-		selectorCardBack  = "#lblInfoIDCardBack1"
-		selectorHeadshot  = "img#ImgPhotoIDCardFront1"
-	)
-
 	name, course, batch := func() (string, string, string) {
 		conDiv := dom.Find(selectorCardFront)
 		// Replace <br>'s with newlines to make the semantic soup parsable
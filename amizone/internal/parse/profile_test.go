@@ -34,7 +34,7 @@ func TestProfile(t *testing.T) {
 			},
 			errMatcher: func(g *GomegaWithT, err error) {
 				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(ContainSubstring(parse.ErrFailedToParse))
+				g.Expect(err).To(MatchError(parse.ErrFailedToParse))
 			},
 		},
 	}
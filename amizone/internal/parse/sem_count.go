@@ -1,7 +1,6 @@
 package parse
 
 import (
-	"errors"
 	"io"
 
 	"github.com/PuerkitoBio/goquery"
@@ -12,15 +11,15 @@ import (
 func Semesters(body io.Reader) (models.SemesterList, error) {
 	dom, err := goquery.NewDocumentFromReader(body)
 	if err != nil {
-		return nil, errors.New(ErrFailedToParseDOM)
+		return nil, errDOM(PageCourses, err)
 	}
 
 	if !IsLoggedInDOM(dom) {
-		return nil, errors.New(ErrNotLoggedIn)
+		return nil, errNotLoggedIn(PageCourses)
 	}
 
 	if !isCoursesPage(dom) {
-		return nil, errors.New(ErrFailedToParse)
+		return nil, errUnexpectedPage(PageCourses)
 	}
 
 	var semesters models.SemesterList
@@ -34,7 +34,7 @@ func TestSemesters(t *testing.T) {
 				g.Expect(courses).To(BeNil())
 			},
 			errMatcher: func(g *GomegaWithT, err error) {
-				g.Expect(err.Error()).To(ContainSubstring(parse.ErrFailedToParse))
+				g.Expect(err).To(MatchError(parse.ErrFailedToParse))
 			},
 		},
 	}
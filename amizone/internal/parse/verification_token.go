@@ -5,19 +5,25 @@ import (
 	"io"
 
 	"github.com/PuerkitoBio/goquery"
-	"k8s.io/klog/v2"
 )
 
 const verificationTokenName = "__RequestVerificationToken"
 
-func VerificationToken(body io.Reader) string {
+var selectorVerificationToken = fmt.Sprintf("input[name='%s']", verificationTokenName)
+
+// VerificationToken retrieves the "__RequestVerificationToken" form value from a page, usually the login page.
+func VerificationToken(body io.Reader) (string, error) {
 	dom, err := goquery.NewDocumentFromReader(body)
 	if err != nil {
-		klog.Errorf("failed to parse login page: %s. Was the right page passed?", err.Error())
+		return "", errDOM(PageLogin, err)
+	}
+	token := VerificationTokenFromDom(dom)
+	if token == "" {
+		return "", errMissing(PageLogin, selectorVerificationToken)
 	}
-	return dom.Find(fmt.Sprintf("input[name='%s']", verificationTokenName)).AttrOr("value", "")
+	return token, nil
 }
 
 func VerificationTokenFromDom(dom *goquery.Document) string {
-	return dom.Find(fmt.Sprintf("input[name='%s']", verificationTokenName)).AttrOr("value", "")
+	return dom.Find(selectorVerificationToken).AttrOr("value", "")
 }
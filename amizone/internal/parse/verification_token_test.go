@@ -14,6 +14,7 @@ func TestVerificationToken(t *testing.T) {
 		name          string
 		bodyFile      mock.File
 		expectedToken string
+		expectedErr   error
 	}{
 		{
 			name:          "login page, verification token exists",
@@ -24,6 +25,7 @@ func TestVerificationToken(t *testing.T) {
 			name:          "home page, verification token does not exist",
 			bodyFile:      mock.HomePageLoggedIn,
 			expectedToken: "",
+			expectedErr:   parse.ErrMissingElement,
 		},
 	}
 
@@ -34,8 +36,13 @@ func TestVerificationToken(t *testing.T) {
 			body, err := tc.bodyFile.Open()
 			g.Expect(err).ToNot(HaveOccurred())
 
-			token := parse.VerificationToken(body)
+			token, err := parse.VerificationToken(body)
 			g.Expect(token).To(Equal(tc.expectedToken))
+			if tc.expectedErr != nil {
+				g.Expect(err).To(MatchError(tc.expectedErr))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
 		})
 	}
 }
@@ -1,8 +1,6 @@
 package parse
 
 import (
-	"errors"
-	"fmt"
 	"io"
 	"net"
 	"strings"
@@ -11,18 +9,28 @@ import (
 	"github.com/ditsuke/go-amizone/amizone/models"
 )
 
+const selectorMacInputs = "input"
+
+var wifiMacRegistrationSchema = pageSchema{
+	elements: []string{selectorMacInputs + "[id^='Mac']", selectorVerificationToken},
+}
+
 func WifiMacInfo(body io.Reader) (*models.WifiMacInfo, error) {
 	dom, err := goquery.NewDocumentFromReader(body)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", ErrFailedToParse, err)
+		return nil, errDOM(PageWifiMacRegistration, err)
+	}
+
+	if !IsLoggedInDOM(dom) {
+		return nil, errNotLoggedIn(PageWifiMacRegistration)
 	}
 
 	macs := make([]net.HardwareAddr, 0, 2)
-	nodes := dom.Find("input").FilterFunction(func(_ int, s *goquery.Selection) bool {
+	nodes := dom.Find(selectorMacInputs).FilterFunction(func(_ int, s *goquery.Selection) bool {
 		return strings.HasPrefix(s.AttrOr("id", ""), "Mac")
 	})
 	if nodes.Length() == 0 {
-		return nil, errors.New(ErrFailedToParse)
+		return nil, errMissing(PageWifiMacRegistration, selectorMacInputs+"[id^='Mac']")
 	}
 
 	nodes.Each(func(_ int, s *goquery.Selection) {
@@ -14,15 +14,18 @@ func (c cookieMap) contains(key string) bool {
 	return false
 }
 
-// IsLoggedIn returns true if the amizone client has the cookies to be logged in.
-// This method does not check if the cookies are still valid.
-func IsLoggedIn(client *http.Client) bool {
+// IsLoggedIn returns true if the amizone client has the cookies to be logged in for the Amizone
+// deployment at baseURL. This method does not check if the cookies are still valid.
+func IsLoggedIn(client *http.Client, baseURL string) bool {
 	jar := client.Jar
 	if jar == nil {
 		return false
 	}
 
-	amizoneUrl, _ := url.Parse("https://" + AmizoneDomain)
+	amizoneUrl, err := url.Parse(baseURL)
+	if err != nil {
+		return false
+	}
 
 	amizoneCookies := func() cookieMap {
 		cookieMap := make(cookieMap)
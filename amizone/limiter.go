@@ -0,0 +1,81 @@
+package amizone
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+)
+
+// Limiter bounds the requests made to Amizone, both in rate, through a token bucket, and in the number of
+// requests in flight at once. A Limiter is safe for concurrent use, and can be shared between clients through
+// WithLimiter to bound their requests together, for example across all the clients of a server.
+type Limiter struct {
+	rate  *rate.Limiter
+	slots chan struct{}
+}
+
+// NewLimiter returns a Limiter letting through requestsPerSecond requests per second on average, with bursts of
+// up to burst requests, and at most maxInFlight requests at once. A non-positive requestsPerSecond disables rate
+// limiting, and a non-positive maxInFlight disables the concurrency cap.
+func NewLimiter(requestsPerSecond float64, burst int, maxInFlight int) *Limiter {
+	l := &Limiter{}
+	if requestsPerSecond > 0 {
+		if burst < 1 {
+			burst = 1
+		}
+		l.rate = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	if maxInFlight > 0 {
+		l.slots = make(chan struct{}, maxInFlight)
+	}
+	return l
+}
+
+// InFlight returns the number of requests currently in flight through the limiter.
+func (l *Limiter) InFlight() int {
+	return len(l.slots)
+}
+
+// acquire blocks until a request can be made within the limits, or ctx is done. On success, the func returned
+// must be called once the request completes.
+func (l *Limiter) acquire(ctx context.Context) (func(), error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
+
+// acquireAll acquires every limiter in limiters, in order, releasing those acquired if one fails.
+func acquireAll(ctx context.Context, limiters []*Limiter) (func(), error) {
+	releases := make([]func(), 0, len(limiters))
+	releaseAll := func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			releases[i]()
+		}
+	}
+	for _, limiter := range limiters {
+		release, err := limiter.acquire(ctx)
+		if err != nil {
+			releaseAll()
+			return nil, err
+		}
+		releases = append(releases, release)
+	}
+	return releaseAll, nil
+}
@@ -0,0 +1,124 @@
+package amizone_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/internal/mock"
+)
+
+func TestClient_Limiter(t *testing.T) {
+	setupNetworking()
+	t.Cleanup(teardown)
+
+	g := NewWithT(t)
+	session, err := createLoggedInClient(g).ExportSession()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var inFlight, maxInFlight, requests int32
+	fakeAmizone := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		atomic.AddInt32(&requests, 1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		f, err := mock.HomePageLoggedIn.Open()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = io.Copy(w, f)
+	}))
+	t.Cleanup(fakeAmizone.Close)
+
+	newClient := func(g *WithT, opts ...amizone.ClientOption) *amizone.Client {
+		jar, err := cookiejar.New(nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		client, err := amizone.NewClientFromSession(
+			session,
+			amizone.Credentials{Username: mock.ValidUser, Password: mock.ValidPass},
+			// An explicit transport keeps us clear of gock's interception of the default transport.
+			&http.Client{Jar: jar, Transport: &http.Transport{}},
+			append([]amizone.ClientOption{amizone.WithBaseURL(fakeAmizone.URL), amizone.WithLogger(logr.Discard())}, opts...)...,
+		)
+		g.Expect(err).ToNot(HaveOccurred())
+		return client
+	}
+	getAttendanceConcurrently := func(g *WithT, clients ...*amizone.Client) {
+		wg := sync.WaitGroup{}
+		for _, client := range clients {
+			for i := 0; i < 3; i++ {
+				wg.Add(1)
+				go func(client *amizone.Client) {
+					defer wg.Done()
+					_, err := client.GetAttendance()
+					g.Expect(err).ToNot(HaveOccurred())
+				}(client)
+			}
+		}
+		wg.Wait()
+	}
+
+	t.Run("concurrency is capped across clients sharing a limiter", func(t *testing.T) {
+		g := NewWithT(t)
+		atomic.StoreInt32(&maxInFlight, 0)
+		limiter := amizone.NewLimiter(0, 0, 2)
+
+		getAttendanceConcurrently(g, newClient(g, amizone.WithLimiter(limiter)), newClient(g, amizone.WithLimiter(limiter)))
+		g.Expect(atomic.LoadInt32(&maxInFlight)).To(BeNumerically("<=", 2))
+		g.Expect(limiter.InFlight()).To(BeZero())
+	})
+
+	t.Run("the strictest of several limiters applies", func(t *testing.T) {
+		g := NewWithT(t)
+		atomic.StoreInt32(&maxInFlight, 0)
+		client := newClient(g, amizone.WithLimiter(amizone.NewLimiter(0, 0, 4)), amizone.WithLimiter(amizone.NewLimiter(0, 0, 1)))
+
+		getAttendanceConcurrently(g, client)
+		g.Expect(atomic.LoadInt32(&maxInFlight)).To(BeNumerically("==", 1))
+	})
+
+	t.Run("request rate is limited", func(t *testing.T) {
+		g := NewWithT(t)
+		atomic.StoreInt32(&requests, 0)
+		// One request up front, then one every 50ms.
+		client := newClient(g, amizone.WithLimiter(amizone.NewLimiter(20, 1, 0)))
+
+		start := time.Now()
+		getAttendanceConcurrently(g, client)
+		g.Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+		g.Expect(atomic.LoadInt32(&requests)).To(BeNumerically("==", 3))
+	})
+
+	t.Run("waiting for the limiter respects the context", func(t *testing.T) {
+		g := NewWithT(t)
+		atomic.StoreInt32(&requests, 0)
+		client := newClient(g, amizone.WithLimiter(amizone.NewLimiter(0.1, 1, 0)))
+
+		_, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = client.GetAttendanceWithContext(ctx)
+		g.Expect(err).To(MatchError(amizone.ErrRateLimited))
+		g.Expect(atomic.LoadInt32(&requests)).To(BeNumerically("==", 1))
+	})
+}
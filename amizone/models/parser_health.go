@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// Problems a ParserFinding can report.
+const (
+	// ProblemMissing is reported for expected elements or columns that aren't on a page at all.
+	ProblemMissing = "missing"
+	// ProblemEmpty is reported for expected elements or columns that are on a page, but never have a value.
+	ProblemEmpty = "empty"
+	// ProblemUnparseable is reported for pages that can't be parsed at all, like JSON endpoints that no longer
+	// serve JSON. Its findings are about the page as a whole, so they have no Selector.
+	ProblemUnparseable = "unparseable"
+)
+
+// ParserFinding is a way in which an Amizone page didn't match what go-amizone's parsers expect of it, usually
+// because Amizone changed its markup.
+type ParserFinding struct {
+	// Selector is the CSS selector of the element expected, or the key of a JSON object for JSON endpoints.
+	Selector string `json:"selector"`
+	// Column is the "data-title" of the table column expected, if the element is a table cell.
+	Column  string `json:"column,omitempty"`
+	Problem string `json:"problem"`
+	// Error is the error the page failed to be parsed with, for ProblemUnparseable findings. It's that of the
+	// last check the finding was made in.
+	Error string `json:"error,omitempty"`
+	// Count is the number of checks of the page the finding was made in.
+	Count    int       `json:"count"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// PageHealth is the result of checking a page against what the parsers expect of it, possibly many times.
+type PageHealth struct {
+	Page        string          `json:"page"`
+	Checks      int             `json:"checks"`
+	LastChecked time.Time       `json:"last_checked"`
+	Findings    []ParserFinding `json:"findings,omitempty"`
+}
+
+// Healthy returns true if no findings were made for the page.
+func (p PageHealth) Healthy() bool {
+	return len(p.Findings) == 0
+}
+
+// ParserHealthReport reports how well the pages fetched from Amizone match what the parsers expect of them, so
+// that changes to the portal can be caught before they break things for users.
+type ParserHealthReport struct {
+	Pages []PageHealth `json:"pages"`
+}
+
+// Healthy returns true if no findings were made for any page.
+func (r ParserHealthReport) Healthy() bool {
+	for _, page := range r.Pages {
+		if !page.Healthy() {
+			return false
+		}
+	}
+	return true
+}
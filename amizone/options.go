@@ -0,0 +1,95 @@
+package amizone
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// Defaults for the configurable aspects of Client.
+const (
+	DefaultLoginCooldown = 2 * time.Minute
+)
+
+// ClientOption configures a Client. Options are passed to NewClient or NewClientWithContext and applied
+// in order, so later options override earlier ones.
+type ClientOption func(*Client)
+
+// WithBaseURL points the client at an alternate Amizone deployment, like a staging mirror or a local fake.
+// The URL must be absolute, for example "http://localhost:8080". Defaults to BaseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request. Defaults to a desktop Firefox user agent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithLogger sets the logger used by the client. Defaults to a klog-backed logr.Logger.
+func WithLogger(logger logr.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithRequestTimeout bounds every individual request made to Amizone, including those made to log in.
+// A zero duration, the default, means no timeout beyond that of the context or the *http.Client.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.requestTimeout = timeout
+	}
+}
+
+// WithLoginCooldown sets the minimum interval between login attempts. Attempts made within the cooldown are
+// skipped to avoid hammering Amizone. Defaults to DefaultLoginCooldown.
+func WithLoginCooldown(cooldown time.Duration) ClientOption {
+	return func(c *Client) {
+		c.loginCooldown = cooldown
+	}
+}
+
+// WithRetryPolicy makes the client retry idempotent requests that fail transiently as configured by policy.
+// Defaults to no retries.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithCircuitBreaker makes the client report the outcome of its requests to breaker, and fail fast with
+// ErrCircuitOpen while breaker is open. Breakers can be shared between clients. Defaults to no circuit breaker.
+func WithCircuitBreaker(breaker *CircuitBreaker) ClientOption {
+	return func(c *Client) {
+		c.circuitBreaker = breaker
+	}
+}
+
+// WithLimiter bounds the requests made by the client with limiter. Limiters can be shared between clients, and
+// the option can be passed more than once to apply several limiters, like one for the client and one shared.
+// Defaults to no limits.
+func WithLimiter(limiter *Limiter) ClientOption {
+	return func(c *Client) {
+		c.limiters = append(c.limiters, limiter)
+	}
+}
+
+// WithHealthMonitor makes the client report the pages it fetches for parsing to monitor, which checks a sample of
+// them against what the parsers expect of them. Monitors can be shared between clients. Defaults to no monitor.
+func WithHealthMonitor(monitor *HealthMonitor) ClientOption {
+	return func(c *Client) {
+		c.healthMonitor = monitor
+	}
+}
+
+// WithSession makes the constructor resume sessionBlob, a session exported by Client.ExportSession, in place of
+// logging in. See NewClientFromSession, which is a shorthand for this option.
+func WithSession(sessionBlob []byte) ClientOption {
+	return func(c *Client) {
+		c.session = sessionBlob
+	}
+}
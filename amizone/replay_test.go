@@ -0,0 +1,105 @@
+package amizone_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/cookiejar"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/cassette"
+)
+
+// cassettesDir holds cassettes recorded from Amizone with TestIntegrateRecordCassette, which
+// TestReplayCassettes replays.
+const cassettesDir = "testdata/cassettes"
+
+// runRecordedFlow logs in and calls the read-only methods of the client, checking that they succeed. It's the
+// flow cassettes are recorded with, so changes to it need cassettes to be recorded again.
+func runRecordedFlow(g *WithT, cred amizone.Credentials, httpClient *http.Client, opts ...amizone.ClientOption) {
+	client, err := amizone.NewClient(cred, httpClient, append(opts, amizone.WithLogger(logr.Discard()))...)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client.DidLogin()).To(BeTrue())
+
+	attendance, err := client.GetAttendance()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(attendance).ToNot(BeEmpty())
+
+	profile, err := client.GetUserProfile()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(profile.Name).ToNot(BeEmpty())
+
+	semesters, err := client.GetSemesters()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(semesters).ToNot(BeEmpty())
+
+	_, err = client.GetCurrentCourses()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = client.GetExamSchedule()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = client.GetWiFiMacInformation()
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+// replayCassette runs the recorded flow against the cassette in dir, with the credentials it was recorded with,
+// checking that every interaction recorded is replayed.
+func replayCassette(g *WithT, dir string) {
+	interactions, err := cassette.Load(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(interactions).ToNot(BeEmpty())
+	replayer := cassette.NewReplayerFromInteractions(interactions)
+	jar, err := cookiejar.New(nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// The password is redacted from cassettes, but the client needs one to log in with.
+	cred := amizone.Credentials{Username: cassette.IdentityFromInteractions(interactions).Username, Password: cassette.Redacted}
+	runRecordedFlow(g, cred, &http.Client{Jar: jar, Transport: replayer})
+	g.Expect(replayer.Unreplayed()).To(BeEmpty(), "the flow should make every request recorded")
+}
+
+func TestReplayCassettes(t *testing.T) {
+	entries, err := os.ReadDir(cassettesDir)
+	if errors.Is(err, os.ErrNotExist) || len(entries) == 0 {
+		t.Skipf("no cassettes in %s", cassettesDir)
+	}
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(cassettesDir, entry.Name())
+		t.Run(entry.Name(), func(t *testing.T) {
+			replayCassette(NewWithT(t), dir)
+		})
+	}
+}
+
+// TestReplayFakePortalCassette records the flow against the fake portal and replays it, to check that cassettes
+// replay without the server they were recorded from.
+func TestReplayFakePortalCassette(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+	server := amizonetest.NewServer(t, nil)
+
+	recorder, err := cassette.NewRecorder(dir, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	jar, err := cookiejar.New(nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	runRecordedFlow(
+		g,
+		amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword},
+		&http.Client{Jar: jar, Transport: recorder},
+		amizone.WithBaseURL(server.URL),
+	)
+	server.Close()
+
+	replayCassette(g, dir)
+}
@@ -2,82 +2,154 @@ package amizone
 
 import (
 	"bytes"
-	"errors"
-	"fmt"
+	"context"
 	"io"
 	"net/http"
 
-	"github.com/ditsuke/go-amizone/amizone/internal"
 	"github.com/ditsuke/go-amizone/amizone/internal/parse"
-	"k8s.io/klog/v2"
-)
-
-const (
-	ErrNon200StatusCode = "received non-200 status code from amizone - is it down?"
 )
 
 // doRequest is an internal http request helper to simplify making requests.
 // This method takes care of both composing requests, setting custom headers and such as needed.
+// The request is bound to ctx, so cancelling it aborts both the request and any login attempted on its behalf.
 // If tryLogin is true, the Client will attempt to log in if it is not already logged in.
 // method must be a valid http request method.
 // endpoint must be relative to BaseUrl.
-func (a *Client) doRequest(tryLogin bool, method string, endpoint string, body io.Reader) (*http.Response, error) {
+func (a *Client) doRequest(ctx context.Context, tryLogin bool, method string, endpoint string, body io.Reader) (*http.Response, error) {
 	if *a.credentials == (Credentials{}) {
-		return nil, fmt.Errorf("%s: invalid credentials", ErrFailedLogin)
+		return nil, ErrMissingCredentials
 	}
 
 	// Login now if we didn't log in at instantiation.
 	if tryLogin && !a.DidLogin() {
-		klog.Infof("doRequest: Attempting to login since we haven't logged in yet.")
-		if err := a.login(); err != nil {
+		a.logger.V(1).Info("doRequest: Attempting to login since we haven't logged in yet.")
+		if err := a.login(ctx); err != nil {
 			return nil, err
 		}
 		tryLogin = false // We don't want to attempt another login.
 	}
 
-	req, err := http.NewRequest(method, BaseURL+endpoint, body)
+	// Buffer the request body, so we can replay it if we have to retry, or to log in first.
+	var requestBody []byte
+	if body != nil {
+		var err error
+		if requestBody, err = io.ReadAll(body); err != nil {
+			return nil, wrapError(ErrFailedToComposeRequest, err)
+		}
+	}
+
+	response, responseBody, err := a.send(ctx, method, endpoint, requestBody)
 	if err != nil {
-		klog.Errorf("%s: %s", ErrFailedToComposeRequest, err)
-		return nil, errors.New(ErrFailedToComposeRequest)
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	// If we're directed to try logging-in and the parser determines we're not, we retry.
+	if tryLogin && *a.credentials != (Credentials{}) && !parse.IsLoggedIn(bytes.NewReader(responseBody)) {
+		a.logger.V(1).Info("doRequest: Attempting to login since we're not logged in (likely: session expired).")
+		if err := a.login(ctx); err != nil {
+			return nil, err
+		}
+		return a.doRequest(ctx, false, method, endpoint, bytes.NewReader(requestBody))
 	}
 
-	req.Header.Set("User-Agent", internal.Firefox99UserAgent)
+	return response, nil
+}
+
+// send makes a request, retrying it as configured by the client's RetryPolicy if it's idempotent. It returns
+// the response along with its body, which has been read and closed.
+func (a *Client) send(ctx context.Context, method string, endpoint string, body []byte) (*http.Response, []byte, error) {
+	maxRetries := 0
+	if isIdempotent(method) {
+		maxRetries = a.retryPolicy.MaxRetries
+	}
+
+	for retry := 0; ; retry++ {
+		response, responseBody, retryable, err := a.sendOnce(ctx, method, endpoint, body)
+		if err == nil || !retryable || retry >= maxRetries {
+			return response, responseBody, err
+		}
+
+		delay := a.retryPolicy.backoff(retry)
+		a.logger.V(1).Info("Retrying request", "endpoint", endpoint, "retry", retry+1, "delay", delay.String(), "error", err.Error())
+		if sleep(ctx, delay) != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// sendOnce makes a single attempt at a request within the client's limits, reporting its outcome to the
+// client's CircuitBreaker, if any.
+// retryable is true if the attempt failed in a way that retrying might fix.
+func (a *Client) sendOnce(ctx context.Context, method string, endpoint string, body []byte) (response *http.Response, responseBody []byte, retryable bool, err error) {
+	release, err := acquireAll(ctx, a.limiters)
+	if err != nil {
+		return nil, nil, false, wrapError(ErrRateLimited, err)
+	}
+	defer release()
+
+	if a.circuitBreaker != nil {
+		if err := a.circuitBreaker.allow(); err != nil {
+			return nil, nil, false, err
+		}
+	}
+	// reachable records whether Amizone was reachable with the circuit breaker.
+	reachable := func(ok bool) {
+		if a.circuitBreaker == nil {
+			return
+		}
+		// Failures caused by the caller's context don't tell us anything about Amizone.
+		if !ok && ctx.Err() != nil {
+			a.circuitBreaker.release()
+			return
+		}
+		a.circuitBreaker.record(ok)
+	}
+
+	requestCtx := ctx
+	if a.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		requestCtx, cancel = context.WithTimeout(ctx, a.requestTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(requestCtx, method, a.baseURL+endpoint, bytes.NewReader(body))
+	if err != nil {
+		reachable(true)
+		a.logger.Error(err, ErrFailedToComposeRequest.Error())
+		return nil, nil, false, ErrFailedToComposeRequest
+	}
+
+	req.Header.Set("User-Agent", a.userAgent)
 	// Amizone uses the referrer to authenticate requests on top of the actual AUTH/session cookies.
-	req.Header.Set("Referer", BaseURL+"/")
+	req.Header.Set("Referer", a.baseURL+"/")
 	if method == http.MethodPost { // We assume a POST request means submitting a form.
 		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	}
 
-	// TODO: check error handling logic following here
-	response, err := a.httpClient.Do(req)
+	response, err = a.httpClient.Do(req)
 	if err != nil {
-		klog.Errorf("Failed to visit endpoint '%s': %s", endpoint, err)
-		return nil, fmt.Errorf("%s: %w", ErrFailedToVisitPage, err)
+		reachable(false)
+		a.logger.Error(err, "Failed to visit endpoint", "endpoint", endpoint)
+		return nil, nil, ctx.Err() == nil, wrapError(ErrFailedToVisitPage, err)
 	}
 
 	// Amizone uses code 200 even for POST requests, so we make sure we have that before proceeding.
 	if response.StatusCode != http.StatusOK {
-		klog.Warningf("Received non-200 status code from endpoint '%s': %d. Amizone down?", endpoint, response.StatusCode)
-		return nil, fmt.Errorf("%s: %d", ErrNon200StatusCode, response.StatusCode)
+		reachable(response.StatusCode < http.StatusInternalServerError)
+		a.logger.Info("Received non-200 status code from endpoint. Amizone down?", "endpoint", endpoint, "status_code", response.StatusCode)
+		_ = response.Body.Close()
+		return nil, nil, isTransientStatus(response.StatusCode), &HTTPError{Method: method, Endpoint: endpoint, StatusCode: response.StatusCode}
 	}
 
 	// Read the response into a byte array, so we can reuse it.
-	responseBody, err := io.ReadAll(response.Body)
-	if err != nil {
-		return response, errors.New(ErrFailedToReadResponse)
-	}
+	responseBody, err = io.ReadAll(response.Body)
 	_ = response.Body.Close()
-
-	response.Body = io.NopCloser(bytes.NewReader(responseBody))
-
-	// If we're directed to try logging-in and the parser determines we're not, we retry.
-	if tryLogin && *a.credentials != (Credentials{}) && !parse.IsLoggedIn(bytes.NewReader(responseBody)) {
-		klog.Infof("doRequest: Attempting to login since we're not logged in (likely: session expired).")
-		if err := a.login(); err != nil {
-			return nil, errors.New(ErrFailedLogin)
-		}
-		return a.doRequest(false, method, endpoint, body)
+	if err != nil {
+		reachable(false)
+		return nil, nil, ctx.Err() == nil, wrapError(ErrFailedToReadResponse, err)
 	}
 
-	return response, nil
+	reachable(true)
+	return response, responseBody, false, nil
 }
@@ -0,0 +1,71 @@
+package amizone
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy configures how a Client retries idempotent requests that fail to reach Amizone or receive a
+// status code indicating a transient failure, like 503. Retries are delayed with exponential backoff and jitter.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries made after the initial attempt.
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry. It doubles with every retry after, up to MaxBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is a RetryPolicy suitable for most uses.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries:     2,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     4 * time.Second,
+}
+
+// backoff returns the delay before the retry-th retry, counting from 0. Delays are jittered between half of
+// and the full exponential delay, so that clients that failed together don't retry together.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 0; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// isIdempotent returns true for request methods that are safe to retry.
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// isTransientStatus returns true for status codes that indicate a failure that might go away on retry.
+func isTransientStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// sleep waits for d, returning early with the context's error if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,126 @@
+package amizone_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/internal/mock"
+)
+
+// newLoggedInClientWithOptions returns a logged-in client configured with opts, and clears the mocks
+// registered for logging in.
+func newLoggedInClientWithOptions(g *WithT, opts ...amizone.ClientOption) *amizone.Client {
+	g.Expect(mock.GockRegisterLoginPage()).ToNot(HaveOccurred())
+	g.Expect(mock.GockRegisterLoginRequest()).ToNot(HaveOccurred())
+	client, err := amizone.NewClient(
+		amizone.Credentials{Username: mock.ValidUser, Password: mock.ValidPass},
+		nil,
+		append([]amizone.ClientOption{amizone.WithLogger(logr.Discard())}, opts...)...,
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	setupNetworking()
+	return client
+}
+
+func TestClient_Retry(t *testing.T) {
+	setupNetworking()
+	t.Cleanup(teardown)
+
+	policy := amizone.RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+	t.Run("transient failures are retried", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newLoggedInClientWithOptions(g, amizone.WithRetryPolicy(policy))
+
+		gock.New(mock.BaseUrl).Get("/Home").Times(2).Reply(http.StatusServiceUnavailable)
+		g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+
+		attendance, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(attendance).To(HaveLen(8))
+		g.Expect(gock.IsDone()).To(BeTrue())
+	})
+
+	t.Run("retries are bounded", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newLoggedInClientWithOptions(g, amizone.WithRetryPolicy(policy))
+
+		gock.New(mock.BaseUrl).Get("/Home").Times(3).Reply(http.StatusBadGateway)
+		g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+
+		_, err := client.GetAttendance()
+		g.Expect(err).To(MatchError(amizone.ErrNon200StatusCode))
+		g.Expect(gock.IsPending()).To(BeTrue(), "the client should give up after MaxRetries retries")
+	})
+
+	t.Run("permanent failures aren't retried", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newLoggedInClientWithOptions(g, amizone.WithRetryPolicy(policy))
+
+		gock.New(mock.BaseUrl).Get("/Home").Reply(http.StatusNotFound)
+		g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+
+		_, err := client.GetAttendance()
+		g.Expect(err).To(MatchError(amizone.ErrNon200StatusCode))
+		g.Expect(gock.IsPending()).To(BeTrue())
+	})
+
+	t.Run("no retries by default", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newLoggedInClientWithOptions(g)
+
+		gock.New(mock.BaseUrl).Get("/Home").Reply(http.StatusServiceUnavailable)
+		g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+
+		_, err := client.GetAttendance()
+		g.Expect(err).To(MatchError(amizone.ErrNon200StatusCode))
+		g.Expect(gock.IsPending()).To(BeTrue())
+	})
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	g := NewWithT(t)
+	setupNetworking()
+	t.Cleanup(teardown)
+
+	const cooldown = 50 * time.Millisecond
+	breaker := amizone.NewCircuitBreaker(2, cooldown)
+	client := newLoggedInClientWithOptions(g, amizone.WithCircuitBreaker(breaker))
+	g.Expect(client.CircuitState()).To(Equal(amizone.CircuitClosed))
+
+	gock.New(mock.BaseUrl).Get("/Home").Times(2).Reply(http.StatusServiceUnavailable)
+	for i := 0; i < 2; i++ {
+		_, err := client.GetAttendance()
+		g.Expect(err).To(MatchError(amizone.ErrNon200StatusCode))
+	}
+	g.Expect(client.CircuitState()).To(Equal(amizone.CircuitOpen))
+
+	// No routes are registered: requests made would fail with another error.
+	_, err := client.GetAttendance()
+	g.Expect(err).To(MatchError(amizone.ErrCircuitOpen))
+
+	// Breakers are shared, so other clients fail fast too.
+	other, err := amizone.NewClient(
+		amizone.Credentials{Username: mock.ValidUser, Password: mock.ValidPass},
+		nil,
+		amizone.WithCircuitBreaker(breaker),
+		amizone.WithLogger(logr.Discard()),
+	)
+	g.Expect(err).To(MatchError(amizone.ErrCircuitOpen))
+	g.Expect(other.CircuitState()).To(Equal(amizone.CircuitOpen))
+
+	time.Sleep(cooldown)
+	g.Expect(breaker.State()).To(Equal(amizone.CircuitHalfOpen))
+
+	g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+	attendance, err := client.GetAttendance()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(attendance).To(HaveLen(8))
+	g.Expect(breaker.State()).To(Equal(amizone.CircuitClosed))
+}
@@ -0,0 +1,100 @@
+package amizone
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/ditsuke/go-amizone/amizone/internal"
+)
+
+// sessionVersion is bumped whenever the layout of exported sessions changes, so that stale
+// sessions are rejected instead of being misinterpreted.
+const sessionVersion = 1
+
+// session is the serialised form of an authenticated Amizone session. It is exported as an opaque blob
+// through Client.ExportSession, so its layout is not part of the public API. It holds nothing but the session
+// itself, so exporting the same session twice yields the same blob.
+type session struct {
+	Version  int               `json:"v"`
+	Username string            `json:"u"`
+	Cookies  map[string]string `json:"c"`
+}
+
+// ExportSession returns an opaque, serialisable blob holding the client's authenticated session, i.e. the
+// cookies Amizone uses to authenticate requests. The blob can later be passed to NewClientFromSession to
+// construct a client without going through the login flow again.
+// The blob grants access to the user's account, so it should be stored with as much care as the credentials.
+func (a *Client) ExportSession() ([]byte, error) {
+	if !internal.IsLoggedIn(a.httpClient, a.baseURL) {
+		return nil, ErrNoSession
+	}
+
+	baseURL, err := url.Parse(a.baseURL)
+	if err != nil {
+		return nil, wrapError(ErrInternalFailure, err)
+	}
+
+	s := session{
+		Version:  sessionVersion,
+		Username: a.credentials.Username,
+		Cookies:  make(map[string]string),
+	}
+	for _, cookie := range a.httpClient.Jar.Cookies(baseURL) {
+		s.Cookies[cookie.Name] = cookie.Value
+	}
+
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return nil, wrapError(ErrInternalFailure, err)
+	}
+	return []byte(base64.RawURLEncoding.EncodeToString(raw)), nil
+}
+
+// NewClientFromSession creates a new client that resumes a session exported by Client.ExportSession instead of
+// logging in. The session isn't verified upfront: if Amizone reports it as expired on the first request, the client
+// falls back to logging in with cred, much like it does when a session expires during its lifetime.
+// The remaining parameters are the same as for NewClient. It's equivalent to passing WithSession to NewClient.
+func NewClientFromSession(sessionBlob []byte, cred Credentials, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
+	return NewClient(cred, httpClient, append(opts, WithSession(sessionBlob))...)
+}
+
+// restoreSession restores a session exported by ExportSession into the client's cookie jar, and marks the client
+// as logged in.
+func (a *Client) restoreSession(sessionBlob []byte) error {
+	raw, err := base64.RawURLEncoding.DecodeString(string(sessionBlob))
+	if err != nil {
+		return wrapError(ErrBadSession, err)
+	}
+	var s session
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return wrapError(ErrBadSession, err)
+	}
+	if s.Version != sessionVersion || len(s.Cookies) == 0 {
+		return ErrBadSession
+	}
+	if s.Username != a.credentials.Username {
+		return ErrSessionMismatch
+	}
+
+	baseURL, err := url.Parse(a.baseURL)
+	if err != nil {
+		return wrapError(ErrInternalFailure, err)
+	}
+	cookies := make([]*http.Cookie, 0, len(s.Cookies))
+	for name, value := range s.Cookies {
+		cookies = append(cookies, &http.Cookie{Name: name, Value: value, Path: "/"})
+	}
+	a.httpClient.Jar.SetCookies(baseURL, cookies)
+
+	if !internal.IsLoggedIn(a.httpClient, a.baseURL) {
+		return ErrBadSession
+	}
+
+	// The restored session is treated as a successful login, so requests are made with it straight away.
+	a.muLogin.Lock()
+	a.muLogin.didLogin = true
+	a.muLogin.Unlock()
+	return nil
+}
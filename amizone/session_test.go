@@ -0,0 +1,97 @@
+package amizone_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/internal/mock"
+)
+
+func TestClient_ExportSession(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	setupNetworking()
+	t.Cleanup(teardown)
+
+	nonLoggedInClient := createNonLoggedInClient(g)
+	_, err := nonLoggedInClient.ExportSession()
+	g.Expect(err).To(MatchError(amizone.ErrNoSession))
+
+	loggedInClient := createLoggedInClient(g)
+	session, err := loggedInClient.ExportSession()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(session).ToNot(BeEmpty())
+	g.Expect(string(session)).ToNot(ContainSubstring(mock.AuthCookie), "session blob should be opaque")
+}
+
+func TestNewClientFromSession(t *testing.T) {
+	setupNetworking()
+	t.Cleanup(teardown)
+
+	validCredentials := amizone.Credentials{Username: mock.ValidUser, Password: mock.ValidPass}
+
+	session := func(g *WithT) []byte {
+		session, err := createLoggedInClient(g).ExportSession()
+		g.Expect(err).ToNot(HaveOccurred())
+		// Drop the login mocks left over from creating the client.
+		setupNetworking()
+		return session
+	}
+
+	t.Run("valid session is used without logging in", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Cleanup(setupNetworking)
+		s := session(g)
+
+		// No login routes are registered, so any login attempt would fail.
+		g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+
+		client, err := amizone.NewClientFromSession(s, validCredentials, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(client.DidLogin()).To(BeTrue())
+
+		attendance, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(attendance).To(HaveLen(8))
+		g.Expect(gock.IsDone()).To(BeTrue())
+	})
+
+	t.Run("expired session falls back to logging in", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Cleanup(setupNetworking)
+		s := session(g)
+
+		// Amizone serves the login page in place of the home page for the first request.
+		// The logged-in home page is registered ahead of the login routes so that it's matched before the
+		// catch-all route for failed logins.
+		g.Expect(mock.GockRegisterUnauthenticatedGet("/Home")).ToNot(HaveOccurred())
+		g.Expect(mock.GockRegisterLoginPage()).ToNot(HaveOccurred())
+		g.Expect(mock.GockRegisterHomePageLoggedIn()).ToNot(HaveOccurred())
+		g.Expect(mock.GockRegisterLoginRequest()).ToNot(HaveOccurred())
+
+		client, err := amizone.NewClientFromSession(s, validCredentials, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		attendance, err := client.GetAttendance()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(attendance).To(HaveLen(8))
+	})
+
+	t.Run("malformed session", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := amizone.NewClientFromSession([]byte("not-a-session"), validCredentials, nil)
+		g.Expect(err).To(MatchError(amizone.ErrBadSession))
+	})
+
+	t.Run("session for another user", func(t *testing.T) {
+		g := NewWithT(t)
+		t.Cleanup(setupNetworking)
+		s := session(g)
+
+		_, err := amizone.NewClientFromSession(s, amizone.Credentials{Username: mock.InvalidUser, Password: mock.InvalidPass}, nil)
+		g.Expect(err).To(MatchError(amizone.ErrSessionMismatch))
+	})
+}
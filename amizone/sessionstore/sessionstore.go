@@ -0,0 +1,311 @@
+// Package sessionstore provides stores for the sessions exported by amizone.Client.ExportSession, so that they
+// can be resumed later instead of logging in again. It's shared by the API server and the CLI.
+package sessionstore
+
+import (
+	"container/list"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Defaults for the session cache.
+const (
+	DefaultCacheSize = 1024
+	DefaultTTL       = 6 * time.Hour
+)
+
+// KeySize is the size, in bytes, of the key used to encrypt sessions at rest.
+const KeySize = 32
+
+var (
+	ErrNotFound = errors.New("session not found")
+	ErrBadKey   = fmt.Errorf("session key must be %d bytes long", KeySize)
+)
+
+// Store persists Amizone sessions exported by amizone.Client.ExportSession, so that its users can resume
+// them instead of logging in on every call. Keys are chosen by the users of the store and are opaque to it.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the session stored for key, or ErrNotFound if there's none or it has expired.
+	Get(key string) ([]byte, error)
+	// Set stores the session for key, replacing any session stored earlier.
+	Set(key string, session []byte) error
+	// Delete removes the session stored for key, if any.
+	Delete(key string) error
+}
+
+// ExpiringStore is a Store that can also store entries until a given time, rather than for a TTL of its own.
+// The API server persists token revocations through it, since revocations must outlast the tokens they revoke.
+type ExpiringStore interface {
+	Store
+	// SetUntil stores the session for key until expiry, replacing any session stored earlier.
+	SetUntil(key string, session []byte, expiry time.Time) error
+}
+
+// MemoryStore is an in-memory Store that holds a bounded number of sessions, evicting the least
+// recently used session when full. Sessions expire after a fixed TTL from when they were stored.
+type MemoryStore struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	entries  map[string]*list.Element
+	lru      *list.List
+}
+
+type memoryEntry struct {
+	key     string
+	session []byte
+	expiry  time.Time
+}
+
+// NewMemoryStore returns a MemoryStore holding up to capacity sessions for ttl each.
+// Non-positive values fall back to DefaultCacheSize and DefaultTTL.
+func NewMemoryStore(capacity int, ttl time.Duration) *MemoryStore {
+	if capacity <= 0 {
+		capacity = DefaultCacheSize
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &MemoryStore{
+		capacity: capacity,
+		ttl:      ttl,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+func (m *MemoryStore) Get(key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	element, ok := m.entries[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	entry := element.Value.(*memoryEntry)
+	if time.Now().After(entry.expiry) {
+		m.remove(element)
+		return nil, ErrNotFound
+	}
+	m.lru.MoveToFront(element)
+	return entry.session, nil
+}
+
+func (m *MemoryStore) Set(key string, session []byte) error {
+	return m.SetUntil(key, session, time.Now().Add(m.ttl))
+}
+
+func (m *MemoryStore) SetUntil(key string, session []byte, expiry time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := &memoryEntry{key: key, session: session, expiry: expiry}
+	if element, ok := m.entries[key]; ok {
+		element.Value = entry
+		m.lru.MoveToFront(element)
+		return nil
+	}
+	m.entries[key] = m.lru.PushFront(entry)
+	for m.lru.Len() > m.capacity {
+		m.remove(m.lru.Back())
+	}
+	return nil
+}
+
+func (m *MemoryStore) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if element, ok := m.entries[key]; ok {
+		m.remove(element)
+	}
+	return nil
+}
+
+// Len returns the number of sessions held by the store, including expired sessions not evicted yet.
+func (m *MemoryStore) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Len()
+}
+
+// remove removes element from the store. The caller must hold m.mu.
+func (m *MemoryStore) remove(element *list.Element) {
+	m.lru.Remove(element)
+	delete(m.entries, element.Value.(*memoryEntry).key)
+}
+
+// SweepInterval is how often a FileStore sweeps the files of expired sessions from its directory. Files are
+// otherwise only removed when their session is read, which many never are again.
+const SweepInterval = time.Hour
+
+// FileStore is a Store that persists sessions as files in a directory, so they survive restarts.
+// Sessions are encrypted at rest with AES-GCM, and file names are derived from keys with HMAC-SHA256, so
+// neither sessions nor keys can be recovered from the directory without the encryption key.
+type FileStore struct {
+	dir  string
+	ttl  time.Duration
+	key  []byte
+	aead cipher.AEAD
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewFileStore returns a FileStore storing sessions in dir, which is created if it doesn't
+// exist, for ttl each. key must be KeySize bytes long. A non-positive ttl falls back to DefaultTTL.
+// The directory is swept when the store is created, and every SweepInterval as sessions are stored.
+func NewFileStore(dir string, key []byte, ttl time.Duration) (*FileStore, error) {
+	if len(key) != KeySize {
+		return nil, ErrBadKey
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create session directory: %w", err)
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	store := &FileStore{
+		dir:  dir,
+		ttl:  ttl,
+		key:  append([]byte(nil), key...),
+		aead: aead,
+	}
+	if err := store.Sweep(); err != nil {
+		return nil, fmt.Errorf("failed to sweep session directory: %w", err)
+	}
+	return store, nil
+}
+
+func (f *FileStore) Get(key string) ([]byte, error) {
+	return f.read(f.path(key))
+}
+
+// read returns the session in the file at path, removing the file if the session has expired or can't be
+// decrypted.
+func (f *FileStore) read(path string) ([]byte, error) {
+	ciphertext, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := f.aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		_ = os.Remove(path)
+		return nil, ErrNotFound
+	}
+	// The file name is bound to the ciphertext as additional data, so sessions can't be swapped between files.
+	plaintext, err := f.aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], []byte(filepath.Base(path)))
+	if err != nil || len(plaintext) < 8 {
+		// Likely written with another key: it's of no use to us.
+		_ = os.Remove(path)
+		return nil, ErrNotFound
+	}
+
+	expiry := time.Unix(int64(binary.BigEndian.Uint64(plaintext[:8])), 0)
+	if time.Now().After(expiry) {
+		_ = os.Remove(path)
+		return nil, ErrNotFound
+	}
+	return plaintext[8:], nil
+}
+
+func (f *FileStore) Set(key string, session []byte) error {
+	return f.SetUntil(key, session, time.Now().Add(f.ttl))
+}
+
+func (f *FileStore) SetUntil(key string, session []byte, expiry time.Time) error {
+	f.mu.Lock()
+	sweep := time.Since(f.lastSweep) >= SweepInterval
+	f.mu.Unlock()
+	if sweep {
+		// The session is stored regardless: stale files are only a waste of space.
+		_ = f.Sweep()
+	}
+	path := f.path(key)
+
+	plaintext := make([]byte, 8, 8+len(session))
+	binary.BigEndian.PutUint64(plaintext, uint64(expiry.Unix()))
+	plaintext = append(plaintext, session...)
+
+	nonce := make([]byte, f.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	ciphertext := f.aead.Seal(nonce, nonce, plaintext, []byte(filepath.Base(path)))
+
+	// Write to a temporary file first, so concurrent readers never see a partially written session.
+	tmp, err := os.CreateTemp(f.dir, ".session-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(ciphertext); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (f *FileStore) Delete(key string) error {
+	err := os.Remove(f.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Sweep removes the files of expired sessions, and of sessions stored with another key, from the store's
+// directory.
+func (f *FileStore) Sweep() error {
+	f.mu.Lock()
+	f.lastSweep = time.Now()
+	f.mu.Unlock()
+
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".session" {
+			continue
+		}
+		// Reading the session removes its file if it's of no use; sessions that can't be read are left alone.
+		_, _ = f.read(filepath.Join(f.dir, entry.Name()))
+	}
+	return nil
+}
+
+// path returns the path of the file holding the session for key.
+func (f *FileStore) path(key string) string {
+	mac := hmac.New(sha256.New, f.key)
+	mac.Write([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(mac.Sum(nil))+".session")
+}
@@ -0,0 +1,155 @@
+package sessionstore_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ditsuke/go-amizone/amizone/sessionstore"
+	. "github.com/onsi/gomega"
+)
+
+func TestStores(t *testing.T) {
+	key := bytes.Repeat([]byte{0x42}, sessionstore.KeySize)
+
+	stores := map[string]func(t *testing.T, ttl time.Duration) sessionstore.Store{
+		"memory": func(t *testing.T, ttl time.Duration) sessionstore.Store {
+			return sessionstore.NewMemoryStore(8, ttl)
+		},
+		"file": func(t *testing.T, ttl time.Duration) sessionstore.Store {
+			store, err := sessionstore.NewFileStore(t.TempDir(), key, ttl)
+			if err != nil {
+				t.Fatalf("failed to create file session store: %s", err)
+			}
+			return store
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			t.Run("set, get and delete", func(t *testing.T) {
+				g := NewWithT(t)
+				store := newStore(t, time.Hour)
+
+				_, err := store.Get("key")
+				g.Expect(err).To(MatchError(sessionstore.ErrNotFound))
+
+				g.Expect(store.Set("key", []byte("session"))).To(Succeed())
+				g.Expect(store.Set("other", []byte("other session"))).To(Succeed())
+				session, err := store.Get("key")
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(session).To(Equal([]byte("session")))
+
+				g.Expect(store.Set("key", []byte("new session"))).To(Succeed())
+				session, err = store.Get("key")
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(session).To(Equal([]byte("new session")))
+
+				g.Expect(store.Delete("key")).To(Succeed())
+				_, err = store.Get("key")
+				g.Expect(err).To(MatchError(sessionstore.ErrNotFound))
+				g.Expect(store.Delete("key")).To(Succeed(), "deleting a missing session should succeed")
+
+				session, err = store.Get("other")
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(session).To(Equal([]byte("other session")))
+			})
+
+			t.Run("sessions expire", func(t *testing.T) {
+				g := NewWithT(t)
+				store := newStore(t, time.Nanosecond)
+
+				g.Expect(store.Set("key", []byte("session"))).To(Succeed())
+				// The file store tracks expiry at a granularity of seconds.
+				time.Sleep(1100 * time.Millisecond)
+				_, err := store.Get("key")
+				g.Expect(err).To(MatchError(sessionstore.ErrNotFound))
+			})
+		})
+	}
+}
+
+func TestMemoryStore_Eviction(t *testing.T) {
+	g := NewWithT(t)
+	store := sessionstore.NewMemoryStore(2, time.Hour)
+
+	g.Expect(store.Set("a", []byte("a"))).To(Succeed())
+	g.Expect(store.Set("b", []byte("b"))).To(Succeed())
+	// Using "a" makes "b" the least recently used session.
+	_, err := store.Get("a")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(store.Set("c", []byte("c"))).To(Succeed())
+
+	g.Expect(store.Len()).To(Equal(2))
+	_, err = store.Get("b")
+	g.Expect(err).To(MatchError(sessionstore.ErrNotFound))
+	_, err = store.Get("a")
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = store.Get("c")
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestFileStore(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+
+	_, err := sessionstore.NewFileStore(dir, []byte("short"), time.Hour)
+	g.Expect(err).To(MatchError(sessionstore.ErrBadKey))
+
+	store, err := sessionstore.NewFileStore(dir, bytes.Repeat([]byte{1}, sessionstore.KeySize), time.Hour)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(store.Set("secret-key", []byte("secret-session"))).To(Succeed())
+
+	files, err := os.ReadDir(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(HaveLen(1))
+	g.Expect(files[0].Name()).ToNot(ContainSubstring("secret-key"))
+	contents, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(contents)).ToNot(ContainSubstring("secret-session"), "sessions should be encrypted at rest")
+
+	// Sessions persist across instances sharing the key...
+	reopened, err := sessionstore.NewFileStore(dir, bytes.Repeat([]byte{1}, sessionstore.KeySize), time.Hour)
+	g.Expect(err).ToNot(HaveOccurred())
+	session, err := reopened.Get("secret-key")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(session).To(Equal([]byte("secret-session")))
+
+	// ...but are of no use with another key.
+	otherKey, err := sessionstore.NewFileStore(dir, bytes.Repeat([]byte{2}, sessionstore.KeySize), time.Hour)
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = otherKey.Get("secret-key")
+	g.Expect(err).To(MatchError(sessionstore.ErrNotFound))
+}
+
+func TestFileStore_Sweep(t *testing.T) {
+	g := NewWithT(t)
+	dir := t.TempDir()
+	key := bytes.Repeat([]byte{1}, sessionstore.KeySize)
+	countFiles := func() int {
+		files, err := os.ReadDir(dir)
+		g.Expect(err).ToNot(HaveOccurred())
+		return len(files)
+	}
+
+	store, err := sessionstore.NewFileStore(dir, key, time.Hour)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(store.Set("live", []byte("session"))).To(Succeed())
+	g.Expect(store.SetUntil("expired", []byte("session"), time.Now().Add(-time.Minute))).To(Succeed())
+	g.Expect(countFiles()).To(Equal(2), "stores shouldn't sweep on every write")
+
+	g.Expect(store.Sweep()).To(Succeed())
+	g.Expect(countFiles()).To(Equal(1))
+	session, err := store.Get("live")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(session).To(Equal([]byte("session")))
+
+	// Stores sweep their directory when they're created, like on restarts.
+	g.Expect(store.SetUntil("expired", nil, time.Now().Add(-time.Minute))).To(Succeed())
+	g.Expect(countFiles()).To(Equal(2))
+	_, err = sessionstore.NewFileStore(dir, key, time.Hour)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(countFiles()).To(Equal(1))
+}
@@ -0,0 +1,121 @@
+// Command amizone-anonymise anonymises cassettes recorded with cassette.Recorder, or pages saved from Amizone,
+// replacing personal information with the placeholders used by go-amizone's fixtures so they can be
+// contributed as testdata.
+//
+// Usage:
+//
+//	amizone-anonymise -out DIR [-bodies] [identity flags] CASSETTE_DIR|FILE...
+package main
+
+import (
+	"flag"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/ditsuke/go-amizone/amizone/cassette"
+)
+
+func main() {
+	logger := klog.NewKlogr()
+
+	var identity cassette.Identity
+	flagSet := flag.NewFlagSet("amizone-anonymise", flag.ExitOnError)
+	out := flagSet.String("out", "", "Directory to write anonymised cassettes and files to")
+	bodies := flagSet.Bool("bodies", false, "Also write the response bodies of cassettes to files, for use as fixtures")
+	flagSet.StringVar(&identity.Username, "username", "", "Amizone ID to scrub; found in cassettes by default")
+	flagSet.StringVar(&identity.Name, "name", "", "Name to scrub; found in cassettes with the ID card page by default")
+	flagSet.StringVar(&identity.EnrollmentNumber, "enrollment", "", "Enrollment number to scrub")
+	flagSet.StringVar(&identity.IDCardNumber, "id-card", "", "ID card number to scrub")
+	flagSet.StringVar(&identity.UUID, "uuid", "", "Student UUID to scrub")
+	dob := flagSet.String("dob", "", "Date of birth to scrub, as YYYY-MM-DD")
+	flagSet.String("v", "", "log verbosity")
+	if err := flagSet.Parse(os.Args[1:]); err != nil {
+		logger.Error(err, "failed to parse flags")
+		os.Exit(1)
+	}
+	if *out == "" || flagSet.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: amizone-anonymise -out DIR [-bodies] [identity flags] CASSETTE_DIR|FILE...")
+		flagSet.PrintDefaults()
+		os.Exit(2)
+	}
+	if *dob != "" {
+		t, err := time.Parse("2006-01-02", *dob)
+		if err != nil {
+			logger.Error(err, "failed to parse date of birth")
+			os.Exit(1)
+		}
+		identity.DateOfBirth = t
+	}
+
+	for _, input := range flagSet.Args() {
+		if err := anonymise(input, *out, *bodies, identity); err != nil {
+			logger.Error(err, "failed to anonymise", "input", input)
+			os.Exit(1)
+		}
+		logger.Info("anonymised", "input", input)
+	}
+	logger.Info("done: review the output before sharing it, anonymisation is best-effort", "out", *out)
+}
+
+// anonymise anonymises input, a cassette directory or a file, into out.
+func anonymise(input, out string, bodies bool, identity cassette.Identity) error {
+	info, err := os.Stat(input)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		raw, err := os.ReadFile(input)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(out, 0o755); err != nil {
+			return err
+		}
+		anonymised := cassette.NewAnonymiser(identity).String(string(raw))
+		return os.WriteFile(filepath.Join(out, filepath.Base(input)), []byte(anonymised), 0o644)
+	}
+
+	outDir := filepath.Join(out, filepath.Base(filepath.Clean(input)))
+	if err := cassette.AnonymiseCassette(input, outDir, identity); err != nil {
+		return err
+	}
+	if !bodies {
+		return nil
+	}
+	interactions, err := cassette.Load(outDir)
+	if err != nil {
+		return err
+	}
+	bodiesDir := filepath.Join(outDir, "bodies")
+	if err := os.MkdirAll(bodiesDir, 0o755); err != nil {
+		return err
+	}
+	for i, interaction := range interactions {
+		if interaction.Response.Body == "" {
+			continue
+		}
+		name := strings.TrimSuffix(cassette.FileName(i+1, interaction), ".json") + bodyExtension(interaction)
+		if err := os.WriteFile(filepath.Join(bodiesDir, name), []byte(interaction.Response.Body), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bodyExtension returns the file extension for the response body of interaction.
+func bodyExtension(interaction cassette.Interaction) string {
+	mediaType, _, _ := mime.ParseMediaType(interaction.Response.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		return ".json"
+	case "text/html":
+		return ".html"
+	}
+	return ".txt"
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"flag"
 	"net/http"
 	"os"
@@ -11,6 +12,8 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/sessionstore"
 	"github.com/ditsuke/go-amizone/server"
 	"github.com/joho/godotenv"
 	"k8s.io/klog/v2"
@@ -19,6 +22,23 @@ import (
 const (
 	DefaultAddress = "0.0.0.0:8081"
 	AddressEnvVar  = "AMIZONE_API_ADDRESS"
+	// AmizoneURLEnvVar overrides the Amizone deployment the server talks to, e.g. to use a fake portal.
+	AmizoneURLEnvVar = "AMIZONE_BASE_URL"
+	// PublicURLEnvVar holds the URL clients reach the server at, which calendar feed URLs are built from.
+	PublicURLEnvVar = "AMIZONE_PUBLIC_URL"
+	// TrustProxyHeadersEnvVar makes the server trust X-Forwarded-* headers, when it's behind a reverse proxy.
+	TrustProxyHeadersEnvVar = "AMIZONE_TRUST_PROXY_HEADERS"
+
+	DefaultSessionStore = "memory"
+	SessionStoreEnvVar  = "AMIZONE_SESSION_STORE"
+	SessionDirEnvVar    = "AMIZONE_SESSION_DIR"
+	// SessionKeyEnvVar holds the base64-encoded key used to encrypt sessions stored on disk.
+	SessionKeyEnvVar = "AMIZONE_SESSION_KEY"
+	// TokenKeyEnvVar holds the base64-encoded key used to sign bearer tokens. Tokens revoked by Logout or
+	// RefreshToken stay revoked across restarts only with the 'file' session store, where revocations are kept.
+	TokenKeyEnvVar = "AMIZONE_TOKEN_KEY"
+	// AdminTokenEnvVar holds the bearer token admin endpoints are authenticated with.
+	AdminTokenEnvVar = "AMIZONE_ADMIN_TOKEN"
 )
 
 func main() {
@@ -29,13 +49,76 @@ func main() {
 
 	flagSet := flag.NewFlagSet("server config", flag.ExitOnError)
 	flagSet.StringVar(&config.BindAddr, "address", EnvOrDefault(AddressEnvVar, DefaultAddress), "Address to listen on")
+	flagSet.StringVar(&config.AmizoneBaseURL, "amizone-url", EnvOrDefault(AmizoneURLEnvVar, amizone.BaseURL), "Base URL of the Amizone deployment to talk to")
+	flagSet.StringVar(&config.PublicURL, "public-url", EnvOrDefault(PublicURLEnvVar, ""), "URL clients reach the server at, e.g. 'https://amizone.example.com', for calendar feed URLs")
+	flagSet.BoolVar(&config.TrustProxyHeaders, "trust-proxy-headers", EnvOrDefault(TrustProxyHeadersEnvVar, false), "Build calendar feed URLs from X-Forwarded-Proto and X-Forwarded-Host when -public-url isn't set; only behind a reverse proxy that sets them")
 	flagSet.StringVar(&config.WellKnownDir, "well-known-dir", "", "Path to the '.well_known' directory used for TLS certificate signing")
+	sessionStore := flagSet.String("session-store", EnvOrDefault(SessionStoreEnvVar, DefaultSessionStore), "Where to cache Amizone sessions: 'memory', 'file' or 'none'")
+	sessionDir := flagSet.String("session-dir", EnvOrDefault(SessionDirEnvVar, ""), "Directory to store sessions in, for the 'file' session store")
+	sessionTTL := flagSet.Duration("session-ttl", sessionstore.DefaultTTL, "How long to cache sessions for")
+	sessionCacheSize := flagSet.Int("session-cache-size", sessionstore.DefaultCacheSize, "Maximum number of sessions cached, for the 'memory' session store")
+	flagSet.DurationVar(&config.TokenTTL, "token-ttl", server.DefaultTokenTTL, "How long bearer tokens are valid for")
+	flagSet.DurationVar(&config.MaxSessionAge, "max-session-age", server.DefaultMaxSessionAge, "How long bearer tokens can be refreshed for after logging in")
+	flagSet.DurationVar(&config.CalendarFeedTTL, "calendar-feed-ttl", server.DefaultCalendarFeedTTL, "How long calendar feed URLs are valid for")
+	flagSet.IntVar(&config.RetryPolicy.MaxRetries, "max-retries", amizone.DefaultRetryPolicy.MaxRetries, "Maximum number of retries for requests to Amizone that fail transiently")
+	circuitThreshold := flagSet.Int("circuit-breaker-threshold", amizone.DefaultCircuitFailureThreshold, "Consecutive failures after which requests to Amizone are short-circuited; 0 disables the circuit breaker")
+	circuitCooldown := flagSet.Duration("circuit-breaker-cooldown", amizone.DefaultCircuitCooldown, "How long to short-circuit requests to Amizone for before trying again")
+	rateLimit := flagSet.Float64("rate-limit", server.DefaultRateLimit, "Requests per second made to Amizone across all users; 0 disables rate limiting")
+	rateBurst := flagSet.Int("rate-burst", server.DefaultRateBurst, "Requests that can be made to Amizone in a burst, beyond the rate limit")
+	maxInFlight := flagSet.Int("max-in-flight", server.DefaultMaxInFlight, "Maximum requests to Amizone in flight at once across all users; 0 disables the cap")
+	parserHealth := flagSet.Bool("parser-health", true, "Check a sample of the pages fetched from Amizone against what the parsers expect of them, for the parser health report")
+	parserHealthSampleRate := flagSet.Int("parser-health-sample-rate", amizone.DefaultHealthSampleRate, "Check one in every this many pages of each kind fetched from Amizone; 1 checks every page")
 	flagSet.String("v", "", "log verbosity")
 	if err := flagSet.Parse(os.Args[1:]); err != nil {
 		logger.Error(err, "failed to parse flags")
 		os.Exit(1)
 	}
 
+	if tokenKey := os.Getenv(TokenKeyEnvVar); tokenKey != "" {
+		key, err := base64.StdEncoding.DecodeString(tokenKey)
+		if err != nil {
+			logger.Error(err, "failed to decode token key", "env", TokenKeyEnvVar)
+			os.Exit(1)
+		}
+		config.TokenKey = key
+	}
+
+	config.RetryPolicy.InitialBackoff = amizone.DefaultRetryPolicy.InitialBackoff
+	config.RetryPolicy.MaxBackoff = amizone.DefaultRetryPolicy.MaxBackoff
+	if *circuitThreshold > 0 {
+		config.CircuitBreaker = amizone.NewCircuitBreaker(*circuitThreshold, *circuitCooldown)
+	}
+
+	if *parserHealth {
+		config.HealthMonitor = amizone.NewHealthMonitor(*parserHealthSampleRate)
+	}
+	config.AdminToken = os.Getenv(AdminTokenEnvVar)
+
+	if *rateLimit > 0 || *maxInFlight > 0 {
+		config.Limiter = amizone.NewLimiter(*rateLimit, *rateBurst, *maxInFlight)
+	}
+
+	switch *sessionStore {
+	case "memory", "":
+		config.SessionStore = sessionstore.NewMemoryStore(*sessionCacheSize, *sessionTTL)
+	case "file":
+		key, err := base64.StdEncoding.DecodeString(os.Getenv(SessionKeyEnvVar))
+		if err != nil {
+			logger.Error(err, "failed to decode session key", "env", SessionKeyEnvVar)
+			os.Exit(1)
+		}
+		store, err := sessionstore.NewFileStore(*sessionDir, key, *sessionTTL)
+		if err != nil {
+			logger.Error(err, "failed to set up file session store", "dir", *sessionDir)
+			os.Exit(1)
+		}
+		config.SessionStore = store
+	case "none":
+	default:
+		logger.Error(nil, "unknown session store", "session_store", *sessionStore)
+		os.Exit(1)
+	}
+
 	s := server.New(config)
 
 	// Start the server on a new go-thread
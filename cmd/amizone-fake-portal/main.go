@@ -0,0 +1,106 @@
+// Command amizone-fake-portal serves a fake Amizone portal for offline development, built from the fixtures
+// go-amizone is tested with. Point clients at it with amizone.WithBaseURL, or the API server with -amizone-url.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/klog/v2"
+
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+)
+
+const (
+	DefaultAddress = "127.0.0.1:8082"
+	AddressEnvVar  = "AMIZONE_FAKE_PORTAL_ADDRESS"
+	// UsersEnvVar holds the users of the portal as comma-separated username:password pairs.
+	UsersEnvVar = "AMIZONE_FAKE_PORTAL_USERS"
+)
+
+func main() {
+	logger := klog.NewKlogr()
+
+	flagSet := flag.NewFlagSet("fake portal config", flag.ExitOnError)
+	address := flagSet.String("address", envOrDefault(AddressEnvVar, DefaultAddress), "Address to listen on")
+	users := flagSet.String("users", envOrDefault(UsersEnvVar, amizonetest.DefaultUsername+":"+amizonetest.DefaultPassword),
+		"Users of the portal, as comma-separated username:password pairs")
+	flagSet.String("v", "", "log verbosity")
+	if err := flagSet.Parse(os.Args[1:]); err != nil {
+		logger.Error(err, "failed to parse flags")
+		os.Exit(1)
+	}
+
+	portalUsers, err := parseUsers(*users)
+	if err != nil {
+		logger.Error(err, "failed to parse users")
+		os.Exit(1)
+	}
+	portal, err := amizonetest.NewPortal(portalUsers...)
+	if err != nil {
+		logger.Error(err, "failed to set up the portal")
+		os.Exit(1)
+	}
+
+	s := &http.Server{Addr: *address, Handler: logRequests(logger, portal)}
+	go func() {
+		logger.Info("starting fake portal", "address", *address, "users", len(portalUsers))
+		if err := s.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			panic(err)
+		}
+	}()
+
+	osChannel := make(chan os.Signal, 1)
+	signal.Notify(osChannel, os.Interrupt, syscall.SIGTERM)
+	sig := <-osChannel
+	logger.Info("os signal received", "signal", sig)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		logger.Error(err, "failed to gracefully shut down the fake portal")
+	}
+}
+
+// parseUsers parses comma-separated username:password pairs.
+func parseUsers(raw string) ([]amizonetest.PortalUser, error) {
+	var users []amizonetest.PortalUser
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		username, password, ok := strings.Cut(pair, ":")
+		if !ok || username == "" {
+			return nil, fmt.Errorf("bad user %q: expected username:password", pair)
+		}
+		users = append(users, amizonetest.PortalUser{Username: username, Password: password})
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("no users")
+	}
+	return users, nil
+}
+
+// logRequests logs every request handled by next.
+func logRequests(logger logr.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.V(1).Info("request", "method", r.Method, "url", r.URL.String())
+		next.ServeHTTP(w, r)
+	})
+}
+
+func envOrDefault(key, def string) string {
+	if env, ok := os.LookupEnv(key); ok {
+		return env
+	}
+	return def
+}
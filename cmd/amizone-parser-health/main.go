@@ -0,0 +1,149 @@
+// Command amizone-parser-health reports how well Amizone's pages match what go-amizone's parsers expect of them,
+// to catch changes to the portal's markup before they break things for users. It checks the pages live with the
+// credentials passed, or fetches the report an API server aggregated from the pages its users fetched.
+//
+// Usage:
+//
+//	amizone-parser-health [-json] [-amizone-url URL]  # with AMIZONE_USERNAME and AMIZONE_PASSWORD set
+//	amizone-parser-health [-json] -server URL         # with AMIZONE_ADMIN_TOKEN set
+//
+// The command exits with status 1 if anything is amiss.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/joho/godotenv"
+	"k8s.io/klog/v2"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/models"
+	"github.com/ditsuke/go-amizone/server"
+)
+
+const (
+	UsernameEnvVar   = "AMIZONE_USERNAME"
+	PasswordEnvVar   = "AMIZONE_PASSWORD"
+	AmizoneURLEnvVar = "AMIZONE_BASE_URL"
+	AdminTokenEnvVar = "AMIZONE_ADMIN_TOKEN"
+)
+
+func main() {
+	logger := klog.NewKlogr()
+	_ = godotenv.Load(".env")
+
+	flagSet := flag.NewFlagSet("amizone-parser-health", flag.ExitOnError)
+	amizoneURL := flagSet.String("amizone-url", envOrDefault(AmizoneURLEnvVar, amizone.BaseURL), "Base URL of the Amizone deployment to check")
+	serverURL := flagSet.String("server", "", "Base URL of an API server to fetch the report of, in place of checking Amizone")
+	asJSON := flagSet.Bool("json", false, "Print the report as JSON")
+	flagSet.String("v", "", "log verbosity")
+	if err := flagSet.Parse(os.Args[1:]); err != nil {
+		logger.Error(err, "failed to parse flags")
+		os.Exit(1)
+	}
+
+	var report *models.ParserHealthReport
+	var err error
+	if *serverURL != "" {
+		report, err = fetchReport(*serverURL, os.Getenv(AdminTokenEnvVar))
+	} else {
+		report, err = checkAmizone(*amizoneURL, amizone.Credentials{
+			Username: os.Getenv(UsernameEnvVar),
+			Password: os.Getenv(PasswordEnvVar),
+		})
+	}
+	if report == nil {
+		logger.Error(err, "failed to get the parser health report")
+		os.Exit(1)
+	}
+	if err != nil {
+		logger.Error(err, "some pages couldn't be checked")
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(report)
+	} else {
+		printReport(os.Stdout, report)
+	}
+	if err != nil || !report.Healthy() {
+		os.Exit(1)
+	}
+}
+
+// checkAmizone checks the pages of the Amizone deployment at baseURL, logged in with cred.
+func checkAmizone(baseURL string, cred amizone.Credentials) (*models.ParserHealthReport, error) {
+	if cred.Username == "" || cred.Password == "" {
+		return nil, fmt.Errorf("%s and %s must be set", UsernameEnvVar, PasswordEnvVar)
+	}
+	client, err := amizone.NewClient(cred, nil, amizone.WithBaseURL(baseURL), amizone.WithLogger(logr.Discard()))
+	if err != nil {
+		return nil, err
+	}
+	return client.CheckParsers()
+}
+
+// fetchReport fetches the report aggregated by the API server at serverURL.
+func fetchReport(serverURL, adminToken string) (*models.ParserHealthReport, error) {
+	if adminToken == "" {
+		return nil, fmt.Errorf("%s must be set", AdminTokenEnvVar)
+	}
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(serverURL, "/")+server.AdminParserHealthPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+	response, err := (&http.Client{Timeout: 30 * time.Second}).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status from the server: %s", response.Status)
+	}
+	var report models.ParserHealthReport
+	if err := json.NewDecoder(response.Body).Decode(&report); err != nil {
+		return nil, fmt.Errorf("decode report: %w", err)
+	}
+	return &report, nil
+}
+
+// printReport prints report as a table of findings by page.
+func printReport(w io.Writer, report *models.ParserHealthReport) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	defer tw.Flush()
+	fmt.Fprintln(tw, "PAGE\tCHECKS\tPROBLEM\tSELECTOR\tSEEN")
+	for _, page := range report.Pages {
+		if page.Healthy() {
+			fmt.Fprintf(tw, "%s\t%d\tok\t\t\n", page.Page, page.Checks)
+			continue
+		}
+		for _, finding := range page.Findings {
+			selector := finding.Selector
+			if finding.Column != "" {
+				selector = fmt.Sprintf("%s (column %q)", selector, finding.Column)
+			}
+			if finding.Error != "" {
+				selector = finding.Error
+			}
+			fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%d/%d\n", page.Page, page.Checks, finding.Problem, selector, finding.Count, page.Checks)
+		}
+	}
+}
+
+func envOrDefault(key, def string) string {
+	if env, ok := os.LookupEnv(key); ok {
+		return env
+	}
+	return def
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"net"
+	"time"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	v1 "github.com/ditsuke/go-amizone/server/gen/go/v1"
+	"github.com/ditsuke/go-amizone/server/transformers/toproto"
+)
+
+// command is a subcommand of the CLI. run returns the result to output, as a message of the API server's where
+// there's one, so that results are output like the server serves them.
+type command struct {
+	usage   string
+	summary string
+	run     func(ctx context.Context, env *cmdEnv, args []string) (any, error)
+	// interactive commands run until the user quits, so the timeout bounds their requests rather than the command.
+	// They write their own output, returning a nil result.
+	interactive bool
+}
+
+// commandNames lists the commands in the order they're listed in the usage.
+var commandNames = []string{"attendance", "schedule", "exams", "semesters", "courses", "results", "profile", "wifi", "feedback", "tui", "profiles"}
+
+var commands = map[string]command{
+	"attendance": {summary: "Attendance for the current semester", run: runAttendance},
+	"schedule":   {usage: "[DATE [END]]", summary: "Classes on DATE (YYYY-MM-DD), today by default, or from DATE through END", run: runSchedule},
+	"exams":      {summary: "The examination schedule", run: runExams},
+	"semesters":  {summary: "Semesters, to pass to courses and results", run: runSemesters},
+	"courses":    {usage: "[SEM]", summary: "Courses of a semester, the current one by default", run: runCourses},
+	"results":    {usage: "[SEM]", summary: "Examination results of a semester, the current one by default", run: runResults},
+	"profile":    {summary: "The user's profile", run: runProfile},
+	"wifi":       {usage: "list | add [-force] MAC | rm MAC", summary: "Wi-Fi MAC addresses registered", run: runWifi},
+	"feedback":   {usage: "-rating N -query-rating N -comment TEXT", summary: "Fill faculty feedback for all faculties", run: runFeedback},
+	"tui":        {usage: "[-refresh DURATION] [DATE]", summary: "An interactive dashboard of classes, attendance, exams and results", run: runTUI, interactive: true},
+	"profiles":   {usage: "list | add NAME | rm NAME", summary: "Profiles, to use with -profile", run: runProfiles},
+}
+
+// dateFormat is the format of dates passed to commands.
+const dateFormat = "2006-01-02"
+
+func runAttendance(ctx context.Context, env *cmdEnv, args []string) (any, error) {
+	if len(args) > 0 {
+		return nil, usagef("unexpected arguments")
+	}
+	client, err := env.loggedIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attendance, err := client.GetAttendanceWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toproto.AttendanceRecords(attendance), nil
+}
+
+func runSchedule(ctx context.Context, env *cmdEnv, args []string) (any, error) {
+	if len(args) > 2 {
+		return nil, usagef("unexpected arguments")
+	}
+	dates := []time.Time{time.Now()}
+	for i, arg := range args {
+		date, err := time.Parse(dateFormat, arg)
+		if err != nil {
+			return nil, usagef("bad date %q: dates are YYYY-MM-DD", arg)
+		}
+		if i == 0 {
+			dates = dates[:0]
+		}
+		dates = append(dates, date)
+	}
+	from, to := dates[0], dates[len(dates)-1]
+	if to.Before(from) || to.Sub(from) >= amizone.MaxClassScheduleRangeDays*24*time.Hour {
+		return nil, usagef("bad range: END must not be before DATE, and ranges can be at most %d days long",
+			amizone.MaxClassScheduleRangeDays)
+	}
+	client, err := env.loggedIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := client.GetClassScheduleRangeWithContext(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	schedule.Sort()
+	return toproto.ScheduledClasses(schedule), nil
+}
+
+func runExams(ctx context.Context, env *cmdEnv, args []string) (any, error) {
+	if len(args) > 0 {
+		return nil, usagef("unexpected arguments")
+	}
+	client, err := env.loggedIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := client.GetExamScheduleWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toproto.ExamSchedule(*schedule), nil
+}
+
+func runSemesters(ctx context.Context, env *cmdEnv, args []string) (any, error) {
+	if len(args) > 0 {
+		return nil, usagef("unexpected arguments")
+	}
+	client, err := env.loggedIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	semesters, err := client.GetSemestersWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toproto.SemesterList(semesters), nil
+}
+
+func runCourses(ctx context.Context, env *cmdEnv, args []string) (any, error) {
+	if len(args) > 1 {
+		return nil, usagef("unexpected arguments")
+	}
+	client, err := env.loggedIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		courses, err := client.GetCurrentCoursesWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return toproto.Courses(courses), nil
+	}
+	courses, err := client.GetCoursesWithContext(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	return toproto.Courses(courses), nil
+}
+
+func runResults(ctx context.Context, env *cmdEnv, args []string) (any, error) {
+	if len(args) > 1 {
+		return nil, usagef("unexpected arguments")
+	}
+	client, err := env.loggedIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		result, err := client.GetCurrentExaminationResultWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return toproto.ExaminationResultRecords(*result), nil
+	}
+	result, err := client.GetExaminationResultWithContext(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	return toproto.ExaminationResultRecords(*result), nil
+}
+
+func runProfile(ctx context.Context, env *cmdEnv, args []string) (any, error) {
+	if len(args) > 0 {
+		return nil, usagef("unexpected arguments")
+	}
+	client, err := env.loggedIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := client.GetUserProfileWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toproto.Profile(*profile), nil
+}
+
+// runWifi lists, registers and removes Wi-Fi MAC addresses. After registering or removing an address, it
+// outputs the addresses registered, like list.
+func runWifi(ctx context.Context, env *cmdEnv, args []string) (any, error) {
+	if len(args) == 0 {
+		return nil, usagef("missing subcommand")
+	}
+	var mutate func(ctx context.Context, env *cmdEnv) error
+	switch args[0] {
+	case "list":
+		if len(args) > 1 {
+			return nil, usagef("unexpected arguments")
+		}
+	case "add":
+		flagSet := flag.NewFlagSet("wifi add", flag.ContinueOnError)
+		flagSet.SetOutput(io.Discard)
+		force := flagSet.Bool("force", false, "Register the address even if all slots are taken, replacing one")
+		if err := flagSet.Parse(args[1:]); err != nil || flagSet.NArg() != 1 {
+			return nil, usagef("wifi add takes a MAC address")
+		}
+		addr, err := net.ParseMAC(flagSet.Arg(0))
+		if err != nil {
+			return nil, usagef("bad MAC address %q", flagSet.Arg(0))
+		}
+		mutate = func(ctx context.Context, env *cmdEnv) error {
+			return env.client.RegisterWifiMacWithContext(ctx, addr, *force)
+		}
+	case "rm":
+		if len(args) != 2 {
+			return nil, usagef("wifi rm takes a MAC address")
+		}
+		addr, err := net.ParseMAC(args[1])
+		if err != nil {
+			return nil, usagef("bad MAC address %q", args[1])
+		}
+		mutate = func(ctx context.Context, env *cmdEnv) error {
+			return env.client.RemoveWifiMacWithContext(ctx, addr)
+		}
+	default:
+		return nil, usagef("unknown subcommand %q", args[0])
+	}
+
+	client, err := env.loggedIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if mutate != nil {
+		if err := mutate(ctx, env); err != nil {
+			return nil, err
+		}
+	}
+	info, err := client.GetWiFiMacInformationWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toproto.WifiInfo(*info), nil
+}
+
+func runFeedback(ctx context.Context, env *cmdEnv, args []string) (any, error) {
+	flagSet := flag.NewFlagSet("feedback", flag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+	rating := flagSet.Int("rating", 0, "Rating for faculties, from 1 to 5")
+	queryRating := flagSet.Int("query-rating", 0, "Rating for query handling, from 1 to 3")
+	comment := flagSet.String("comment", "", "Comment for faculties")
+	if err := flagSet.Parse(args); err != nil || flagSet.NArg() > 0 {
+		return nil, usagef("bad arguments")
+	}
+	if *rating == 0 || *queryRating == 0 || *comment == "" {
+		return nil, usagef("-rating, -query-rating and -comment are required")
+	}
+	client, err := env.loggedIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filled, err := client.SubmitFacultyFeedbackHackWithContext(ctx, int32(*rating), int32(*queryRating), *comment)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.FillFacultyFeedbackResponse{FilledFor: filled}, nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/ditsuke/go-amizone/amizone"
+)
+
+// options are the values of the command's flags.
+type options struct {
+	username   string
+	password   string
+	configPath string
+	output     string
+	amizoneURL string
+	profile    string
+	timeout    time.Duration
+}
+
+// fileConfig is the contents of the config file.
+type fileConfig struct {
+	Username   string `yaml:"username"`
+	Password   string `yaml:"password"`
+	AmizoneURL string `yaml:"amizone_url"`
+	Output     string `yaml:"output"`
+	Profile    string `yaml:"profile"`
+}
+
+// settings are what the command runs with, resolved from the flags, the environment and the config file.
+type settings struct {
+	credentials amizone.Credentials
+	amizoneURL  string
+	output      string
+	// profile is the name of the profile in use, if any.
+	profile string
+	// configDir is the directory of the config file, which holds the profile store.
+	configDir string
+}
+
+// resolveSettings resolves settings from opts, the environment variables read with getenv and the config file,
+// in that order of precedence. If a profile is selected, its credentials and URL are loaded from the config
+// directory with loadProfile and take the place of those in the environment and config file. A nil loadProfile
+// ignores profiles, for commands that manage them.
+func resolveSettings(opts options, getenv func(string) string, loadProfile func(dir, name string) (profile, error)) (settings, error) {
+	configPath, explicit := opts.configPath, opts.configPath != ""
+	if configPath == "" {
+		configPath, explicit = getenv(ConfigEnvVar), getenv(ConfigEnvVar) != ""
+	}
+	if configPath == "" {
+		var err error
+		if configPath, err = defaultConfigPath(); err != nil {
+			return settings{}, err
+		}
+	}
+	config, err := loadConfig(configPath)
+	if err != nil && (explicit || !errors.Is(err, fs.ErrNotExist)) {
+		return settings{}, err
+	}
+
+	s := settings{
+		output:    firstNonEmpty(opts.output, config.Output, formatTable),
+		profile:   firstNonEmpty(opts.profile, getenv(ProfileEnvVar), config.Profile),
+		configDir: filepath.Dir(configPath),
+	}
+	if loadProfile == nil {
+		s.profile = ""
+	}
+	if s.profile != "" {
+		p, err := loadProfile(s.configDir, s.profile)
+		if err != nil {
+			return settings{}, err
+		}
+		s.credentials = amizone.Credentials{
+			Username: firstNonEmpty(opts.username, p.Username),
+			Password: firstNonEmpty(opts.password, p.Password),
+		}
+		s.amizoneURL = firstNonEmpty(opts.amizoneURL, p.AmizoneURL, amizone.BaseURL)
+	} else {
+		s.credentials = amizone.Credentials{
+			Username: firstNonEmpty(opts.username, getenv(UsernameEnvVar), config.Username),
+			Password: firstNonEmpty(opts.password, getenv(PasswordEnvVar), config.Password),
+		}
+		s.amizoneURL = firstNonEmpty(opts.amizoneURL, getenv(AmizoneURLEnvVar), config.AmizoneURL, amizone.BaseURL)
+	}
+	if !isFormat(s.output) {
+		return settings{}, fmt.Errorf("unknown output format %q", s.output)
+	}
+	return s, nil
+}
+
+// defaultConfigPath returns the path of the config file in the user's config directory.
+func defaultConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("find config directory: %w", err)
+	}
+	return filepath.Join(dir, "amizone", "config.yaml"), nil
+}
+
+// loadConfig reads the config file at path.
+func loadConfig(path string) (fileConfig, error) {
+	var config fileConfig
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return config, fmt.Errorf("read config: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(raw, &config); err != nil {
+		return config, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,259 @@
+// Command amizone is a command-line client for Amizone, built on go-amizone.
+//
+// Usage:
+//
+//	amizone [flags] <command> [arguments]
+//
+// The commands are:
+//
+//	attendance        attendance for the current semester
+//	schedule [DATE [END]]
+//	                  classes on DATE (YYYY-MM-DD), today by default, or from DATE through END
+//	exams             the examination schedule
+//	semesters         semesters, to pass to courses and results
+//	courses [SEM]     courses of semester SEM, the current one by default
+//	results [SEM]     examination results of semester SEM, the current one by default
+//	profile           the user's profile
+//	wifi list         Wi-Fi MAC addresses registered
+//	wifi add [-force] MAC
+//	wifi rm MAC
+//	feedback -rating N -query-rating N -comment TEXT
+//	                  fill faculty feedback for all faculties
+//	tui [-refresh D] [DATE]
+//	                  an interactive dashboard
+//	profiles list     profiles in the profile store
+//	profiles add NAME
+//	profiles rm NAME
+//
+// Credentials are taken from the -username and -password flags, the AMIZONE_USERNAME and AMIZONE_PASSWORD
+// environment variables, or the config file, in that order. The config file is YAML, at amizone/config.yaml in
+// the user's config directory by default:
+//
+//	username: "..."
+//	password: "..."
+//	amizone_url: "..."   # optional
+//	output: json         # optional
+//	profile: work        # optional, see below
+//
+// Profiles keep the credentials of several accounts in profiles.json, next to the config file, encrypted with a
+// key derived from a passphrase read from AMIZONE_PASSPHRASE or asked for. "profiles add NAME" adds a profile with
+// the credentials the command is run with, and -profile NAME (or AMIZONE_PROFILE, or profile in the config file)
+// uses it in place of the credentials in the environment and config file. Commands run with a profile cache its
+// session, encrypted too, and resume it on the next run instead of logging in again.
+//
+// Output is a table by default, or JSON (as served by the API server) or YAML with -output. The command exits
+// with one of the Exit* codes, telling apart failures to log in, Amizone being down, and pages that failed to
+// parse.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/ditsuke/go-amizone/amizone"
+)
+
+const (
+	UsernameEnvVar   = "AMIZONE_USERNAME"
+	PasswordEnvVar   = "AMIZONE_PASSWORD"
+	AmizoneURLEnvVar = "AMIZONE_BASE_URL"
+	// ConfigEnvVar overrides the path of the config file.
+	ConfigEnvVar = "AMIZONE_CONFIG"
+	// ProfileEnvVar selects the profile to use.
+	ProfileEnvVar = "AMIZONE_PROFILE"
+	// PassphraseEnvVar holds the passphrase of the profile store, which is asked for otherwise.
+	PassphraseEnvVar = "AMIZONE_PASSPHRASE"
+
+	DefaultTimeout = 30 * time.Second
+)
+
+// Exit codes.
+const (
+	ExitOK = 0
+	// ExitFailure is the exit code of failures not covered by the other codes.
+	ExitFailure = 1
+	// ExitUsage is the exit code of bad invocations.
+	ExitUsage = 2
+	// ExitAuth is the exit code of failures to log in, with missing or invalid credentials.
+	ExitAuth = 3
+	// ExitUnavailable is the exit code of failures caused by Amizone being down or unreachable.
+	ExitUnavailable = 4
+	// ExitParse is the exit code of failures to parse pages, which usually means Amizone changed.
+	ExitParse = 5
+	// ExitRejected is the exit code of requests rejected for their input, like invalid MAC addresses.
+	ExitRejected = 6
+)
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdin, os.Stdout, os.Stderr, os.Getenv))
+}
+
+// run runs the command with args, reading prompted input from stdin, writing output to stdout and errors to
+// stderr, and returns its exit code.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer, getenv func(string) string) int {
+	var opts options
+	flagSet := flag.NewFlagSet("amizone", flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	flagSet.Usage = func() { printUsage(flagSet) }
+	flagSet.StringVar(&opts.username, "username", "", "Amizone username; overrides "+UsernameEnvVar+" and the config file")
+	flagSet.StringVar(&opts.password, "password", "", "Amizone password; overrides "+PasswordEnvVar+" and the config file")
+	flagSet.StringVar(&opts.configPath, "config", "", "Path of the config file; overrides "+ConfigEnvVar)
+	flagSet.StringVar(&opts.output, "output", "", "Output format: 'table', 'json' or 'yaml'; defaults to the config file's, or 'table'")
+	flagSet.StringVar(&opts.profile, "profile", "", "Profile to use, from the profile store; overrides "+ProfileEnvVar)
+	flagSet.StringVar(&opts.amizoneURL, "amizone-url", "", "Base URL of the Amizone deployment to talk to; overrides "+AmizoneURLEnvVar)
+	flagSet.DurationVar(&opts.timeout, "timeout", DefaultTimeout, "Timeout for the whole command, or for each refresh of the dashboard")
+	if err := flagSet.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+	if flagSet.NArg() == 0 {
+		printUsage(flagSet)
+		return ExitUsage
+	}
+
+	name, cmdArgs := flagSet.Arg(0), flagSet.Args()[1:]
+	if name == "help" {
+		printUsage(flagSet)
+		return ExitOK
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "amizone: unknown command %q\n", name)
+		printUsage(flagSet)
+		return ExitUsage
+	}
+
+	env := &cmdEnv{getenv: getenv, stdin: stdin, stdout: stdout, stderr: stderr}
+	loadProfile := env.loadProfile
+	if name == "profiles" {
+		loadProfile = nil
+	}
+	settings, err := resolveSettings(opts, getenv, loadProfile)
+	if err != nil {
+		fmt.Fprintf(stderr, "amizone: %s\n", err)
+		return ExitUsage
+	}
+	env.settings = settings
+
+	env.timeout = opts.timeout
+	if !cmd.interactive {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.timeout)
+		defer cancel()
+	}
+	result, err := cmd.run(ctx, env, cmdArgs)
+	env.cacheSession(err)
+	if err != nil {
+		var usageErr *usageError
+		if errors.As(err, &usageErr) {
+			fmt.Fprintf(stderr, "amizone %s: %s\nusage: amizone %s %s\n", name, usageErr.msg, name, cmd.usage)
+			return ExitUsage
+		}
+		fmt.Fprintf(stderr, "amizone: %s\n", err)
+		return exitCode(err)
+	}
+	if result == nil {
+		return ExitOK
+	}
+	if err := writeOutput(stdout, settings.output, result); err != nil {
+		fmt.Fprintf(stderr, "amizone: %s\n", err)
+		return ExitFailure
+	}
+	return ExitOK
+}
+
+// exitCode maps err, returned by the client, to an exit code.
+func exitCode(err error) int {
+	// Failures to log in because Amizone is down match ErrFailedLogin too, so they're told apart first.
+	switch {
+	case amizone.IsUnavailable(err), errors.Is(err, context.DeadlineExceeded), errors.Is(err, amizone.ErrRateLimited):
+		return ExitUnavailable
+	case errors.Is(err, amizone.ErrFailedLogin):
+		return ExitAuth
+	case errors.Is(err, amizone.ErrFailedToParsePage):
+		return ExitParse
+	case errors.Is(err, amizone.ErrInvalidMac), errors.Is(err, amizone.ErrNoMacSlots),
+		errors.Is(err, amizone.ErrInvalidRating), errors.Is(err, amizone.ErrInvalidQueryRating),
+		errors.Is(err, amizone.ErrEmptyComment), errors.Is(err, amizone.ErrFailedToRegisterMac),
+		errors.Is(err, amizone.ErrFailedToRemoveMac):
+		return ExitRejected
+	}
+	return ExitFailure
+}
+
+// cmdEnv is the environment commands run in.
+type cmdEnv struct {
+	settings settings
+	getenv   func(string) string
+	stdin    io.Reader
+	stdout   io.Writer
+	stderr   io.Writer
+	// timeout bounds the whole of non-interactive commands, and each request of interactive ones.
+	timeout time.Duration
+	client  *amizone.Client
+	// profiles is the profile store, once opened.
+	profiles *profileStore
+}
+
+// loggedIn returns a client logged in with the credentials in the settings, logging in on the first call. With
+// a profile, the session cached for the profile is resumed instead, if there's one.
+func (e *cmdEnv) loggedIn(ctx context.Context) (*amizone.Client, error) {
+	if e.client != nil {
+		return e.client, nil
+	}
+	// The client doesn't log in without credentials, so it would fail on the first request instead.
+	if e.settings.credentials.Username == "" || e.settings.credentials.Password == "" {
+		return nil, amizone.ErrMissingCredentials
+	}
+	opts := []amizone.ClientOption{amizone.WithBaseURL(e.settings.amizoneURL), amizone.WithLogger(logr.Discard())}
+	if session := e.cachedSession(); session != nil {
+		client, err := amizone.NewClientWithContext(ctx, e.settings.credentials, nil, append(opts, amizone.WithSession(session))...)
+		if err == nil {
+			e.client = client
+			return client, nil
+		}
+	}
+	client, err := amizone.NewClientWithContext(ctx, e.settings.credentials, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	e.client = client
+	return client, nil
+}
+
+// usageError is returned by commands invoked with bad arguments.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usagef(format string, a ...any) error {
+	return &usageError{msg: fmt.Sprintf(format, a...)}
+}
+
+func printUsage(flagSet *flag.FlagSet) {
+	w := flagSet.Output()
+	fmt.Fprintln(w, "usage: amizone [flags] <command> [arguments]")
+	fmt.Fprintln(w, "\ncommands:")
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, name := range commandNames {
+		cmd := commands[name]
+		fmt.Fprintf(tw, "  %s %s\t%s\n", name, cmd.usage, cmd.summary)
+	}
+	_ = tw.Flush()
+	fmt.Fprintln(w, "\nflags:")
+	flagSet.PrintDefaults()
+}
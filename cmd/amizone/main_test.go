@@ -0,0 +1,261 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+)
+
+// cliResult is the outcome of a run of the command.
+type cliResult struct {
+	code   int
+	stdout string
+	stderr string
+}
+
+// runCLI runs the command with args against server, with env as the environment. The config directory is
+// pointed at an empty temporary directory, so a config file on the machine running the tests isn't picked up.
+func runCLI(t *testing.T, server *amizonetest.Server, env map[string]string, args ...string) cliResult {
+	t.Helper()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	if server != nil {
+		args = append([]string{"-amizone-url", server.URL}, args...)
+	}
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, nil, &stdout, &stderr, func(key string) string { return env[key] })
+	return cliResult{code: code, stdout: stdout.String(), stderr: stderr.String()}
+}
+
+// credentialsEnv is an environment with the credentials of the default user of a fake Amizone.
+var credentialsEnv = map[string]string{
+	UsernameEnvVar: amizonetest.DefaultUsername,
+	PasswordEnvVar: amizonetest.DefaultPassword,
+}
+
+func TestRun_Commands(t *testing.T) {
+	server := amizonetest.NewServer(t, nil)
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expect []string
+	}{
+		{name: "attendance", args: []string{"attendance"}, expect: []string{"CODE", "MATH242", "95.83%"}},
+		{name: "schedule", args: []string{"schedule", "2022-09-05"}, expect: []string{"START", "10:15", "CSE208"}},
+		{
+			name:   "schedule of several days",
+			args:   []string{"schedule", "2022-09-05", "2022-09-06"},
+			expect: []string{"Mon 2022-09-05 10:15", "Tue 2022-09-06 14:15", "IT201"},
+		},
+		{name: "exams", args: []string{"exams"}, expect: []string{"End Semester Examination", "2022-05-11 10:00", "FREN144"}},
+		{name: "semesters", args: []string{"semesters"}, expect: []string{"REF", "4"}},
+		{name: "current courses", args: []string{"courses"}, expect: []string{"INTERNALS", "EVS102", "35/40"}},
+		{name: "courses of a semester", args: []string{"courses", "1"}, expect: []string{"CODE"}},
+		{name: "current results", args: []string{"results"}, expect: []string{"GRADE", "CSE207", "SGPA", "8.21"}},
+		{name: "profile", args: []string{"profile"}, expect: []string{"John Doe", "A2305221007"}},
+		{name: "wifi list", args: []string{"wifi", "list"}, expect: []string{"55:04:2d:e7:be:a4", "0 of 2 slots free"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			result := runCLI(t, server, credentialsEnv, testCase.args...)
+			g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+			for _, expect := range testCase.expect {
+				g.Expect(result.stdout).To(ContainSubstring(expect))
+			}
+		})
+	}
+}
+
+func TestRun_TableKeepsRowsOnOneLine(t *testing.T) {
+	g := NewWithT(t)
+	server := amizonetest.NewServer(t, nil)
+
+	// A course name in the results fixture spans two lines.
+	result := runCLI(t, server, credentialsEnv, "results")
+	g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+	g.Expect(result.stdout).To(ContainSubstring("Written Expression & Comprehension in Spanish - I "))
+}
+
+func TestRun_OutputFormats(t *testing.T) {
+	server := amizonetest.NewServer(t, nil)
+
+	t.Run("json", func(t *testing.T) {
+		g := NewWithT(t)
+		result := runCLI(t, server, credentialsEnv, "-output", "json", "semesters")
+		g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+		g.Expect(result.stdout).To(MatchJSON(`{"semesters": [
+			{"name": "4", "ref": "4"}, {"name": "3", "ref": "3"}, {"name": "2", "ref": "2"}, {"name": "1", "ref": "1"}
+		]}`))
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		g := NewWithT(t)
+		result := runCLI(t, server, credentialsEnv, "-output", "yaml", "wifi", "list")
+		g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+		var info struct {
+			Addresses []string `yaml:"addresses"`
+			Slots     int      `yaml:"slots"`
+			FreeSlots int      `yaml:"freeSlots"`
+		}
+		g.Expect(yaml.Unmarshal([]byte(result.stdout), &info)).To(Succeed())
+		g.Expect(info.Addresses).To(ConsistOf("55:04:2d:e7:be:a4", "fd:d5:14:18:0c:8b"))
+		g.Expect(info.Slots).To(Equal(2))
+		g.Expect(info.FreeSlots).To(Equal(0))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		g := NewWithT(t)
+		result := runCLI(t, server, credentialsEnv, "-output", "xml", "semesters")
+		g.Expect(result.code).To(Equal(ExitUsage))
+		g.Expect(result.stderr).To(ContainSubstring(`unknown output format "xml"`))
+	})
+}
+
+func TestRun_Wifi(t *testing.T) {
+	g := NewWithT(t)
+	const mac = "02:00:5e:10:00:01"
+	server := amizonetest.NewServer(t, amizonetest.NewScenario().WithUsers(amizonetest.PortalUser{
+		Username: amizonetest.DefaultUsername,
+		Password: amizonetest.DefaultPassword,
+		WifiMacs: []net.HardwareAddr{},
+	}))
+
+	result := runCLI(t, server, credentialsEnv, "wifi", "add", mac)
+	g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+	g.Expect(result.stdout).To(ContainSubstring(mac))
+	g.Expect(result.stdout).To(ContainSubstring("1 of 2 slots free"))
+	g.Expect(server.Portal.WifiMacs(amizonetest.DefaultUsername)).To(HaveLen(1))
+
+	result = runCLI(t, server, credentialsEnv, "wifi", "rm", mac)
+	g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+	g.Expect(result.stdout).ToNot(ContainSubstring(mac))
+	g.Expect(server.Portal.WifiMacs(amizonetest.DefaultUsername)).To(BeEmpty())
+}
+
+func TestRun_ExitCodes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		scenario *amizonetest.Scenario
+		env      map[string]string
+		args     []string
+		expect   int
+	}{
+		{name: "no command", args: []string{}, expect: ExitUsage},
+		{name: "unknown command", args: []string{"grades"}, expect: ExitUsage},
+		{name: "help", args: []string{"help"}, expect: ExitOK},
+		{name: "unknown flag", args: []string{"-verbose", "attendance"}, expect: ExitUsage},
+		{name: "unexpected arguments", args: []string{"attendance", "now"}, expect: ExitUsage},
+		{name: "bad date", args: []string{"schedule", "05/09/2022"}, expect: ExitUsage},
+		{name: "backwards range", args: []string{"schedule", "2022-09-06", "2022-09-05"}, expect: ExitUsage},
+		{name: "long range", args: []string{"schedule", "2022-09-01", "2022-11-02"}, expect: ExitUsage},
+		{name: "bad MAC address", args: []string{"wifi", "add", "not-a-mac"}, expect: ExitUsage},
+		{name: "missing feedback flags", args: []string{"feedback", "-rating", "5"}, expect: ExitUsage},
+		{name: "invalid rating", args: []string{"feedback", "-rating", "9", "-query-rating", "1", "-comment", "ok"}, expect: ExitRejected},
+		{name: "no free slots", args: []string{"wifi", "add", "02:00:5e:10:00:01"}, expect: ExitRejected},
+		{name: "missing credentials", env: map[string]string{}, args: []string{"profile"}, expect: ExitAuth},
+		{
+			name:   "invalid credentials",
+			env:    map[string]string{UsernameEnvVar: amizonetest.DefaultUsername, PasswordEnvVar: "wrong"},
+			args:   []string{"profile"},
+			expect: ExitAuth,
+		},
+		{
+			name:     "amizone down",
+			scenario: amizonetest.NewScenario().WithErrors("/", http.StatusServiceUnavailable, -1),
+			args:     []string{"profile"},
+			expect:   ExitUnavailable,
+		},
+		{
+			name:     "page failing after login",
+			scenario: amizonetest.NewScenario().WithErrors("/IDCard", http.StatusBadGateway, -1),
+			args:     []string{"profile"},
+			expect:   ExitUnavailable,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			env := testCase.env
+			if env == nil {
+				env = credentialsEnv
+			}
+			server := amizonetest.NewServer(t, testCase.scenario)
+			result := runCLI(t, server, env, testCase.args...)
+			g.Expect(result.code).To(Equal(testCase.expect), result.stderr)
+		})
+	}
+}
+
+func TestRun_Config(t *testing.T) {
+	server := amizonetest.NewServer(t, nil)
+
+	writeConfig := func(t *testing.T, config string) string {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	fileConfig := "username: " + amizonetest.DefaultUsername + "\npassword: " + amizonetest.DefaultPassword + "\noutput: json\n"
+
+	t.Run("credentials and output from the file", func(t *testing.T) {
+		g := NewWithT(t)
+		path := writeConfig(t, fileConfig)
+		result := runCLI(t, server, map[string]string{}, "-config", path, "semesters")
+		g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+		g.Expect(json.Valid([]byte(result.stdout))).To(BeTrue())
+	})
+
+	t.Run("file from the environment", func(t *testing.T) {
+		g := NewWithT(t)
+		path := writeConfig(t, fileConfig)
+		result := runCLI(t, server, map[string]string{ConfigEnvVar: path}, "semesters")
+		g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+		g.Expect(json.Valid([]byte(result.stdout))).To(BeTrue())
+	})
+
+	t.Run("flags and environment override the file", func(t *testing.T) {
+		g := NewWithT(t)
+		path := writeConfig(t, "username: someone\npassword: wrong\noutput: json\n")
+
+		result := runCLI(t, server, map[string]string{}, "-config", path, "profile")
+		g.Expect(result.code).To(Equal(ExitAuth))
+
+		result = runCLI(t, server, credentialsEnv, "-config", path, "-output", "table", "profile")
+		g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+		g.Expect(result.stdout).To(ContainSubstring("John Doe"))
+
+		env := map[string]string{UsernameEnvVar: amizonetest.DefaultUsername, PasswordEnvVar: "wrong"}
+		result = runCLI(t, server, env, "-config", path, "-password", amizonetest.DefaultPassword, "profile")
+		g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		g := NewWithT(t)
+		result := runCLI(t, server, credentialsEnv, "-config", filepath.Join(t.TempDir(), "missing.yaml"), "profile")
+		g.Expect(result.code).To(Equal(ExitUsage))
+		g.Expect(result.stderr).To(ContainSubstring("read config"))
+	})
+
+	t.Run("unknown keys", func(t *testing.T) {
+		g := NewWithT(t)
+		path := writeConfig(t, "user: someone\n")
+		result := runCLI(t, server, credentialsEnv, "-config", path, "profile")
+		g.Expect(result.code).To(Equal(ExitUsage))
+		g.Expect(result.stderr).To(ContainSubstring("parse config"))
+	})
+}
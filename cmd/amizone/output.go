@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v2"
+
+	v1 "github.com/ditsuke/go-amizone/server/gen/go/v1"
+)
+
+// Output formats.
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+func isFormat(format string) bool {
+	return format == formatTable || format == formatJSON || format == formatYAML
+}
+
+// jsonOptions marshal messages like the API server's REST gateway does.
+var jsonOptions = protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}
+
+// writeOutput writes result to w in format. Messages are marshalled like the API server does; other results,
+// which have no counterpart in the API, are marshalled as they are.
+func writeOutput(w io.Writer, format string, result any) error {
+	msg, ok := result.(proto.Message)
+	if !ok {
+		return writeValue(w, format, result)
+	}
+	switch format {
+	case formatJSON:
+		raw, err := jsonOptions.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", raw)
+		return err
+	case formatYAML:
+		raw, err := jsonOptions.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		// JSON is YAML, and a MapSlice keeps the fields in the order protojson marshals them in.
+		var doc yaml.MapSlice
+		if err := yaml.Unmarshal(raw, &doc); err != nil {
+			return err
+		}
+		out, err := yaml.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+	}
+	return writeTable(w, msg)
+}
+
+// writeValue writes result, which isn't a message, to w in format.
+func writeValue(w io.Writer, format string, result any) error {
+	switch format {
+	case formatJSON:
+		raw, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", raw)
+		return err
+	case formatYAML:
+		raw, err := yaml.Marshal(result)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(raw)
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	switch result := result.(type) {
+	case profileList:
+		fmt.Fprintln(tw, "NAME\tUSERNAME\tAMIZONE URL")
+		for _, p := range result.Profiles {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", p.Name, p.Username, firstNonEmpty(p.AmizoneURL, "-"))
+		}
+	default:
+		return fmt.Errorf("no table format for %T", result)
+	}
+	return tw.Flush()
+}
+
+// writeTable writes msg to w as a table.
+func writeTable(w io.Writer, msg proto.Message) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	switch msg := msg.(type) {
+	case *v1.AttendanceRecords:
+		fmt.Fprintln(tw, "CODE\tCOURSE\tATTENDED\tHELD\tPERCENT")
+		for _, record := range msg.GetRecords() {
+			attendance := record.GetAttendance()
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%s\n", record.GetCourse().GetCode(), oneLine(record.GetCourse().GetName()),
+				attendance.GetAttended(), attendance.GetHeld(), percent(attendance.GetAttended(), attendance.GetHeld()))
+		}
+	case *v1.ScheduledClasses:
+		// Schedules of several days start with the date of the classes.
+		startLayout := "15:04"
+		for _, class := range msg.GetClasses() {
+			if formatTime(class.GetStartTime(), dateFormat) != formatTime(msg.GetClasses()[0].GetStartTime(), dateFormat) {
+				startLayout = "Mon 2006-01-02 15:04"
+				break
+			}
+		}
+		fmt.Fprintln(tw, "START\tEND\tCODE\tCOURSE\tFACULTY\tROOM\tATTENDANCE")
+		for _, class := range msg.GetClasses() {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", formatTime(class.GetStartTime(), startLayout),
+				formatTime(class.GetEndTime(), "15:04"), class.GetCourse().GetCode(), oneLine(class.GetCourse().GetName()),
+				class.GetFaculty(), class.GetRoom(), class.GetAttendance())
+		}
+	case *v1.ExaminationSchedule:
+		if msg.GetTitle() != "" {
+			fmt.Fprintln(tw, msg.GetTitle())
+		}
+		fmt.Fprintln(tw, "TIME\tCODE\tCOURSE\tMODE\tLOCATION")
+		for _, exam := range msg.GetExams() {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", formatTime(exam.GetTime(), "2006-01-02 15:04"),
+				exam.GetCourse().GetCode(), oneLine(exam.GetCourse().GetName()), exam.GetMode(), oneLine(exam.GetLocation()))
+		}
+	case *v1.SemesterList:
+		fmt.Fprintln(tw, "REF\tNAME")
+		for _, semester := range msg.GetSemesters() {
+			fmt.Fprintf(tw, "%s\t%s\n", semester.GetRef(), oneLine(semester.GetName()))
+		}
+	case *v1.Courses:
+		fmt.Fprintln(tw, "CODE\tCOURSE\tTYPE\tATTENDANCE\tINTERNALS")
+		for _, course := range msg.GetCourses() {
+			attendance, marks := course.GetAttendance(), course.GetInternalMarks()
+			internals := "-"
+			if marks.GetMax() != 0 {
+				internals = fmt.Sprintf("%g/%g", marks.GetHave(), marks.GetMax())
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%d/%d\t%s\n", course.GetRef().GetCode(), oneLine(course.GetRef().GetName()),
+				course.GetType(), attendance.GetAttended(), attendance.GetHeld(), internals)
+		}
+	case *v1.ExamResultRecords:
+		fmt.Fprintln(tw, "CODE\tCOURSE\tGRADE\tGRADE POINT\tCREDITS\tPUBLISHED")
+		for _, record := range msg.GetCourseWise() {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d/%d\t%s\n", record.GetCourse().GetCode(), oneLine(record.GetCourse().GetName()),
+				record.GetScore().GetGrade(), record.GetScore().GetGradePoint(), record.GetCredits().GetAcquired(),
+				record.GetCredits().GetEffective(), formatDate(record.GetPublishDate()))
+		}
+		if len(msg.GetOverall()) > 0 {
+			fmt.Fprintln(tw, "\nSEMESTER\tSGPA\tCGPA")
+			for _, overall := range msg.GetOverall() {
+				fmt.Fprintf(tw, "%s\t%.2f\t%.2f\n", overall.GetSemester().GetSemesterRef(),
+					overall.GetSemesterGradePointAverage(), overall.GetCumulativeGradePointAverage())
+			}
+		}
+	case *v1.Profile:
+		for _, field := range [][2]string{
+			{"Name", oneLine(msg.GetName())},
+			{"Enrollment number", msg.GetEnrollmentNumber()},
+			{"Enrollment validity", formatTime(msg.GetEnrollmentValidity(), "2006-01-02")},
+			{"Batch", msg.GetBatch()},
+			{"Program", msg.GetProgram()},
+			{"Date of birth", formatTime(msg.GetDateOfBirth(), "2006-01-02")},
+			{"Blood group", msg.GetBloodGroup()},
+			{"ID card number", msg.GetIdCardNumber()},
+			{"UUID", msg.GetUuid()},
+		} {
+			fmt.Fprintf(tw, "%s:\t%s\n", field[0], field[1])
+		}
+	case *v1.WifiMacInfo:
+		fmt.Fprintln(tw, "ADDRESS")
+		for _, address := range msg.GetAddresses() {
+			fmt.Fprintln(tw, address)
+		}
+		fmt.Fprintf(tw, "\n%d of %d slots free\n", msg.GetFreeSlots(), msg.GetSlots())
+	case *v1.FillFacultyFeedbackResponse:
+		fmt.Fprintf(tw, "Filled feedback for %d faculties\n", msg.GetFilledFor())
+	default:
+		return fmt.Errorf("no table format for %T", msg)
+	}
+	return tw.Flush()
+}
+
+// oneLine joins the lines of s, like course names that Amizone wraps, so they don't break table rows.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func percent(attended, held int32) string {
+	if held == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f%%", float64(attended)*100/float64(held))
+}
+
+// formatTime formats ts with layout, or returns "-" for unset timestamps. Amizone's times are wall-clock times
+// in India, which the parsers keep as UTC, so they're formatted in UTC rather than the local time zone.
+func formatTime(ts *timestamppb.Timestamp, layout string) string {
+	if ts == nil {
+		return "-"
+	}
+	return ts.AsTime().Format(layout)
+}
+
+func formatDate(d *date.Date) string {
+	if d == nil || d.GetYear() == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.GetYear(), d.GetMonth(), d.GetDay())
+}
@@ -0,0 +1,387 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/sessionstore"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/term"
+)
+
+// Profiles are named sets of credentials, kept in an encrypted store next to the config file so that several
+// accounts can be used without keeping passwords in plaintext. The store is encrypted with AES-GCM under a key
+// derived from a passphrase, and holds the key for the sessions cached for profiles too, so commands run with a
+// profile resume its session instead of logging in every time.
+
+const (
+	// profileStoreName is the name of the file holding the profile store, in the config directory.
+	profileStoreName = "profiles.json"
+	// sessionDirName is the name of the directory holding the sessions cached for profiles, in the config directory.
+	sessionDirName = "sessions"
+
+	profileStoreVersion = 1
+	saltSize            = 16
+)
+
+// kdfIterations is the number of PBKDF2 iterations keys are derived with for new stores. Stores record the
+// number they were created with, so it can be raised without breaking existing stores. It's a variable so that
+// tests can lower it.
+var kdfIterations = 600_000
+
+var (
+	errWrongPassphrase = errors.New("wrong passphrase, or the profile store is corrupt")
+	errNoPassphrase    = errors.New("no passphrase for the profile store: set " + PassphraseEnvVar + " or enter one when prompted")
+)
+
+// profile is a named set of credentials.
+type profile struct {
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	AmizoneURL string `json:"amizone_url,omitempty"`
+}
+
+// sealedProfileStore is the layout of the profile store on disk.
+type sealedProfileStore struct {
+	Version    int    `json:"version"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// profileStore is the decrypted profile store.
+type profileStore struct {
+	path       string
+	iterations int
+	salt       []byte
+	key        []byte
+	profiles   map[string]profile
+}
+
+// openProfileStore opens the profile store in dir with passphrase, or returns an empty store, which is created
+// on the first save, if there's none.
+func openProfileStore(dir, passphrase string) (*profileStore, error) {
+	store := &profileStore{path: filepath.Join(dir, profileStoreName), profiles: make(map[string]profile)}
+	raw, err := os.ReadFile(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		store.iterations = kdfIterations
+		store.salt = make([]byte, saltSize)
+		if _, err := io.ReadFull(rand.Reader, store.salt); err != nil {
+			return nil, err
+		}
+		store.key = pbkdf2.Key([]byte(passphrase), store.salt, store.iterations, sha256.Size, sha256.New)
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read profile store: %w", err)
+	}
+
+	var sealed sealedProfileStore
+	if err := json.Unmarshal(raw, &sealed); err != nil || sealed.Version != profileStoreVersion || sealed.Iterations <= 0 {
+		return nil, fmt.Errorf("%s isn't a profile store this version understands", store.path)
+	}
+	store.iterations, store.salt = sealed.Iterations, sealed.Salt
+	store.key = pbkdf2.Key([]byte(passphrase), store.salt, store.iterations, sha256.Size, sha256.New)
+	aead, err := store.aead()
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, sealed.Nonce, sealed.Ciphertext, sealed.Salt)
+	if err != nil {
+		return nil, errWrongPassphrase
+	}
+	if err := json.Unmarshal(plaintext, &store.profiles); err != nil {
+		return nil, errWrongPassphrase
+	}
+	return store, nil
+}
+
+// names returns the names of the profiles in the store, sorted.
+func (s *profileStore) names() []string {
+	names := make([]string, 0, len(s.profiles))
+	for name := range s.profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// get returns the profile called name.
+func (s *profileStore) get(name string) (profile, error) {
+	p, ok := s.profiles[name]
+	if !ok {
+		return profile{}, fmt.Errorf("no profile %q", name)
+	}
+	return p, nil
+}
+
+// save encrypts the store and writes it to disk, replacing the store on disk atomically.
+func (s *profileStore) save() error {
+	plaintext, err := json.Marshal(s.profiles)
+	if err != nil {
+		return err
+	}
+	aead, err := s.aead()
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	raw, err := json.MarshalIndent(sealedProfileStore{
+		Version:    profileStoreVersion,
+		Iterations: s.iterations,
+		Salt:       s.salt,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, s.salt),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".profiles-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(raw); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// sessions returns the store of the sessions cached for the profiles, which are encrypted with a key derived
+// from the store's.
+func (s *profileStore) sessions() (*sessionstore.FileStore, error) {
+	return sessionstore.NewFileStore(filepath.Join(filepath.Dir(s.path), sessionDirName), s.subkey("sessions"), sessionstore.DefaultTTL)
+}
+
+func (s *profileStore) aead() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(s.subkey("profiles"))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// subkey derives a key for purpose from the store's key, so the profiles and sessions aren't encrypted with
+// the same key.
+func (s *profileStore) subkey(purpose string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// profileStore opens the profile store in dir, asking for the passphrase if it isn't in the environment. The
+// store is opened once per run.
+func (e *cmdEnv) profileStore(dir string) (*profileStore, error) {
+	if e.profiles != nil {
+		return e.profiles, nil
+	}
+	passphrase := e.getenv(PassphraseEnvVar)
+	if passphrase == "" {
+		_, statErr := os.Stat(filepath.Join(dir, profileStoreName))
+		var err error
+		if passphrase, err = e.promptPassphrase(errors.Is(statErr, os.ErrNotExist)); err != nil {
+			return nil, err
+		}
+	}
+	store, err := openProfileStore(dir, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	e.profiles = store
+	return store, nil
+}
+
+// promptPassphrase asks for the passphrase of the profile store on stdin, twice if confirm is set.
+func (e *cmdEnv) promptPassphrase(confirm bool) (string, error) {
+	if e.stdin == nil {
+		return "", errNoPassphrase
+	}
+	reader := bufio.NewReader(e.stdin)
+	read := func(prompt string) (string, error) {
+		fmt.Fprint(e.stderr, prompt)
+		line, err := reader.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return "", errNoPassphrase
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+	// Don't echo the passphrase back when it's typed at a terminal.
+	if f, ok := e.stdin.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		read = func(prompt string) (string, error) {
+			fmt.Fprint(e.stderr, prompt)
+			passphrase, err := term.ReadPassword(int(f.Fd()))
+			fmt.Fprintln(e.stderr)
+			if err != nil {
+				return "", errNoPassphrase
+			}
+			return string(passphrase), nil
+		}
+	}
+	passphrase, err := read("Passphrase for profiles: ")
+	if err != nil || passphrase == "" {
+		return "", errNoPassphrase
+	}
+	if confirm {
+		again, err := read("Passphrase again, to create the profile store: ")
+		if err != nil {
+			return "", err
+		}
+		if again != passphrase {
+			return "", errors.New("passphrases don't match")
+		}
+	}
+	return passphrase, nil
+}
+
+// loadProfile returns the profile called name from the store in dir, for resolving settings.
+func (e *cmdEnv) loadProfile(dir, name string) (profile, error) {
+	store, err := e.profileStore(dir)
+	if err != nil {
+		return profile{}, err
+	}
+	return store.get(name)
+}
+
+// cachedSession returns the session cached for the profile in use, if any.
+func (e *cmdEnv) cachedSession() []byte {
+	if e.settings.profile == "" {
+		return nil
+	}
+	sessions, err := e.profiles.sessions()
+	if err != nil {
+		return nil
+	}
+	blob, err := sessions.Get(e.settings.profile)
+	if err != nil {
+		return nil
+	}
+	return blob
+}
+
+// cacheSession caches the session of the client for the profile in use, or drops the cached session if the
+// command failed to log in.
+func (e *cmdEnv) cacheSession(cmdErr error) {
+	if e.settings.profile == "" || e.profiles == nil {
+		return
+	}
+	sessions, err := e.profiles.sessions()
+	if err != nil {
+		return
+	}
+	if errors.Is(cmdErr, amizone.ErrFailedLogin) && !amizone.IsUnavailable(cmdErr) {
+		_ = sessions.Delete(e.settings.profile)
+		return
+	}
+	if e.client == nil {
+		return
+	}
+	if blob, err := e.client.ExportSession(); err == nil {
+		_ = sessions.Set(e.settings.profile, blob)
+	}
+}
+
+// profileList is the output of the profiles command.
+type profileList struct {
+	Profiles []profileSummary `json:"profiles" yaml:"profiles"`
+}
+
+type profileSummary struct {
+	Name       string `json:"name" yaml:"name"`
+	Username   string `json:"username" yaml:"username"`
+	AmizoneURL string `json:"amizoneUrl,omitempty" yaml:"amizoneUrl,omitempty"`
+}
+
+// runProfiles lists, adds and removes profiles. Profiles are added with the credentials the command is run
+// with, after checking that they log in, and the session they log in with is cached for the profile.
+func runProfiles(ctx context.Context, env *cmdEnv, args []string) (any, error) {
+	if len(args) == 0 {
+		return nil, usagef("missing subcommand")
+	}
+	switch args[0] {
+	case "list":
+		if len(args) > 1 {
+			return nil, usagef("unexpected arguments")
+		}
+	case "add":
+		if len(args) != 2 {
+			return nil, usagef("profiles add takes a profile name")
+		}
+		if env.settings.credentials.Username == "" || env.settings.credentials.Password == "" {
+			return nil, usagef("pass the credentials of the profile with -username and -password, or the environment")
+		}
+		store, err := env.profileStore(env.settings.configDir)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := env.loggedIn(ctx); err != nil {
+			return nil, err
+		}
+		p := profile{Username: env.settings.credentials.Username, Password: env.settings.credentials.Password}
+		if env.settings.amizoneURL != amizone.BaseURL {
+			p.AmizoneURL = env.settings.amizoneURL
+		}
+		store.profiles[args[1]] = p
+		if err := store.save(); err != nil {
+			return nil, err
+		}
+		env.settings.profile = args[1]
+	case "rm":
+		if len(args) != 2 {
+			return nil, usagef("profiles rm takes a profile name")
+		}
+		store, err := env.profileStore(env.settings.configDir)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := store.get(args[1]); err != nil {
+			return nil, err
+		}
+		delete(store.profiles, args[1])
+		if err := store.save(); err != nil {
+			return nil, err
+		}
+		if sessions, err := store.sessions(); err == nil {
+			_ = sessions.Delete(args[1])
+		}
+	default:
+		return nil, usagef("unknown subcommand %q", args[0])
+	}
+
+	store, err := env.profileStore(env.settings.configDir)
+	if err != nil {
+		return nil, err
+	}
+	list := profileList{Profiles: []profileSummary{}}
+	for _, name := range store.names() {
+		p := store.profiles[name]
+		list.Profiles = append(list.Profiles, profileSummary{Name: name, Username: p.Username, AmizoneURL: p.AmizoneURL})
+	}
+	return list, nil
+}
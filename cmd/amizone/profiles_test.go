@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+)
+
+// useFastKDF lowers the number of PBKDF2 iterations for stores created by the test, to keep it quick.
+func useFastKDF(t *testing.T) {
+	iterations := kdfIterations
+	kdfIterations = 1000
+	t.Cleanup(func() { kdfIterations = iterations })
+}
+
+func TestProfileStore(t *testing.T) {
+	g := NewWithT(t)
+	useFastKDF(t)
+	dir := t.TempDir()
+
+	store, err := openProfileStore(dir, "correct horse")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(store.names()).To(BeEmpty())
+	store.profiles["work"] = profile{Username: "alice", Password: "hunter2"}
+	store.profiles["home"] = profile{Username: "bob", Password: "swordfish", AmizoneURL: "http://127.0.0.1:8082"}
+	g.Expect(store.save()).To(Succeed())
+
+	raw, err := os.ReadFile(filepath.Join(dir, profileStoreName))
+	g.Expect(err).ToNot(HaveOccurred())
+	for _, secret := range []string{"alice", "hunter2", "swordfish", "work"} {
+		g.Expect(string(raw)).ToNot(ContainSubstring(secret), "the store should be encrypted")
+	}
+	info, err := os.Stat(filepath.Join(dir, profileStoreName))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+
+	reopened, err := openProfileStore(dir, "correct horse")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reopened.names()).To(Equal([]string{"home", "work"}))
+	g.Expect(reopened.get("home")).To(Equal(store.profiles["home"]))
+	_, err = reopened.get("school")
+	g.Expect(err).To(MatchError(`no profile "school"`))
+
+	_, err = openProfileStore(dir, "wrong horse")
+	g.Expect(err).To(MatchError(errWrongPassphrase))
+}
+
+// emptyConfig returns the path of an empty config file in a temporary directory, which the profile store is
+// kept next to.
+func emptyConfig(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// countingProxy proxies requests to server, counting the logins made through it.
+func countingProxy(t *testing.T, server *amizonetest.Server) (*httptest.Server, *int32) {
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	var logins int32
+	counting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/" {
+			atomic.AddInt32(&logins, 1)
+		}
+		proxy.ServeHTTP(w, r)
+	}))
+	t.Cleanup(counting.Close)
+	return counting, &logins
+}
+
+func TestRun_Profiles(t *testing.T) {
+	g := NewWithT(t)
+	useFastKDF(t)
+	server := amizonetest.NewServer(t, nil)
+	proxy, logins := countingProxy(t, server)
+	configPath := emptyConfig(t)
+
+	passphraseEnv := map[string]string{PassphraseEnvVar: "correct horse"}
+	withCredentials := map[string]string{
+		UsernameEnvVar:   amizonetest.DefaultUsername,
+		PasswordEnvVar:   amizonetest.DefaultPassword,
+		PassphraseEnvVar: "correct horse",
+	}
+	runProfileCLI := func(env map[string]string, args ...string) cliResult {
+		return runCLI(t, nil, env, append([]string{"-config", configPath}, args...)...)
+	}
+
+	// Adding a profile logs in with the credentials, and caches the session.
+	result := runProfileCLI(withCredentials, "-amizone-url", proxy.URL, "profiles", "add", "work")
+	g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+	g.Expect(result.stdout).To(ContainSubstring("work"))
+	g.Expect(result.stdout).To(ContainSubstring(amizonetest.DefaultUsername))
+	g.Expect(atomic.LoadInt32(logins)).To(BeEquivalentTo(1))
+
+	// Commands run with the profile resume the session, with the URL stored for the profile.
+	for i := 0; i < 2; i++ {
+		result = runProfileCLI(passphraseEnv, "-profile", "work", "attendance")
+		g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+		g.Expect(result.stdout).To(ContainSubstring("MATH242"))
+	}
+	g.Expect(atomic.LoadInt32(logins)).To(BeEquivalentTo(1), "the cached session should be resumed")
+
+	// Expired sessions are replaced by logging in again.
+	server.Portal.ExpireSessions()
+	result = runProfileCLI(passphraseEnv, "-profile", "work", "attendance")
+	g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+	g.Expect(atomic.LoadInt32(logins)).To(BeEquivalentTo(2))
+	result = runProfileCLI(map[string]string{PassphraseEnvVar: "correct horse", ProfileEnvVar: "work"}, "profile")
+	g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+	g.Expect(atomic.LoadInt32(logins)).To(BeEquivalentTo(2))
+
+	// Sessions are encrypted at rest too.
+	sessions, err := os.ReadDir(filepath.Join(filepath.Dir(configPath), sessionDirName))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(sessions).To(HaveLen(1))
+	raw, err := os.ReadFile(filepath.Join(filepath.Dir(configPath), sessionDirName, sessions[0].Name()))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(raw)).ToNot(ContainSubstring(amizonetest.DefaultUsername))
+
+	result = runProfileCLI(map[string]string{PassphraseEnvVar: "wrong horse"}, "-profile", "work", "attendance")
+	g.Expect(result.code).To(Equal(ExitUsage))
+	g.Expect(result.stderr).To(ContainSubstring("wrong passphrase"))
+
+	result = runProfileCLI(passphraseEnv, "-output", "json", "profiles", "list")
+	g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+	var list profileList
+	g.Expect(json.Unmarshal([]byte(result.stdout), &list)).To(Succeed())
+	g.Expect(list.Profiles).To(Equal([]profileSummary{
+		{Name: "work", Username: amizonetest.DefaultUsername, AmizoneURL: proxy.URL},
+	}))
+
+	// Removing the profile drops its session.
+	result = runProfileCLI(passphraseEnv, "profiles", "rm", "work")
+	g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+	g.Expect(result.stdout).ToNot(ContainSubstring(amizonetest.DefaultUsername))
+	sessions, err = os.ReadDir(filepath.Join(filepath.Dir(configPath), sessionDirName))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(sessions).To(BeEmpty())
+
+	result = runProfileCLI(passphraseEnv, "-profile", "work", "attendance")
+	g.Expect(result.code).To(Equal(ExitUsage))
+	g.Expect(result.stderr).To(ContainSubstring(`no profile "work"`))
+}
+
+func TestRun_ProfilesRejectBadCredentials(t *testing.T) {
+	g := NewWithT(t)
+	useFastKDF(t)
+	server := amizonetest.NewServer(t, nil)
+	configPath := emptyConfig(t)
+
+	env := map[string]string{
+		UsernameEnvVar:   amizonetest.DefaultUsername,
+		PasswordEnvVar:   "wrong",
+		PassphraseEnvVar: "correct horse",
+	}
+	result := runCLI(t, server, env, "-config", configPath, "profiles", "add", "work")
+	g.Expect(result.code).To(Equal(ExitAuth))
+	_, err := os.Stat(filepath.Join(filepath.Dir(configPath), profileStoreName))
+	g.Expect(os.IsNotExist(err)).To(BeTrue(), "no profile should be saved")
+}
+
+func TestRun_ProfilesPromptForPassphrase(t *testing.T) {
+	g := NewWithT(t)
+	useFastKDF(t)
+	server := amizonetest.NewServer(t, nil)
+	configPath := emptyConfig(t)
+	args := []string{"-config", configPath, "-amizone-url", server.URL}
+	runWithStdin := func(stdin string, env map[string]string, args ...string) cliResult {
+		var stdout, stderr bytes.Buffer
+		code := run(context.Background(), args, strings.NewReader(stdin), &stdout, &stderr,
+			func(key string) string { return env[key] })
+		return cliResult{code: code, stdout: stdout.String(), stderr: stderr.String()}
+	}
+
+	// Creating the store asks for the passphrase twice.
+	result := runWithStdin("correct horse\ncorrect horse\n", credentialsEnv, append(args, "profiles", "add", "work")...)
+	g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+	g.Expect(result.stderr).To(ContainSubstring("Passphrase again"))
+
+	result = runWithStdin("correct horse\n", nil, append(args, "-profile", "work", "semesters")...)
+	g.Expect(result.code).To(Equal(ExitOK), result.stderr)
+
+	result = runWithStdin("", nil, append(args, "-profile", "work", "semesters")...)
+	g.Expect(result.code).To(Equal(ExitUsage))
+	g.Expect(result.stderr).To(ContainSubstring(PassphraseEnvVar))
+}
@@ -0,0 +1,434 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+// DefaultRefreshInterval is how often the dashboard reloads its data by default.
+const DefaultRefreshInterval = 5 * time.Minute
+
+// minAttendance is the attendance percentage students need to keep, below which courses are highlighted.
+const minAttendance = 75
+
+// runTUI runs the dashboard until the user quits.
+func runTUI(ctx context.Context, env *cmdEnv, args []string) (any, error) {
+	flagSet := flag.NewFlagSet("tui", flag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+	refresh := flagSet.Duration("refresh", DefaultRefreshInterval, "How often to reload the dashboard; 0 to reload only on demand")
+	if err := flagSet.Parse(args); err != nil || flagSet.NArg() > 1 {
+		return nil, usagef("bad arguments")
+	}
+	date := time.Now()
+	if flagSet.NArg() == 1 {
+		var err error
+		if date, err = time.ParseInLocation(dateFormat, flagSet.Arg(0), time.Local); err != nil {
+			return nil, usagef("bad date %q: dates are YYYY-MM-DD", flagSet.Arg(0))
+		}
+	}
+
+	// Logging in fails the command like others, rather than leaving the user with an empty dashboard.
+	loginCtx, cancel := context.WithTimeout(ctx, env.timeout)
+	client, err := env.loggedIn(loginCtx)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	d := newDashboard(ctx, client, lipgloss.NewRenderer(env.stdout), date)
+	d.timeout, d.refresh = env.timeout, *refresh
+	program := tea.NewProgram(d, tea.WithContext(ctx), tea.WithInput(env.stdin), tea.WithOutput(env.stdout), tea.WithAltScreen())
+	if _, err := program.Run(); err != nil && !errors.Is(err, tea.ErrProgramKilled) {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// dashboardStyles are the styles the dashboard is rendered with.
+type dashboardStyles struct {
+	title, heading, muted, err lipgloss.Style
+	good, warn, bad            lipgloss.Style
+}
+
+func newDashboardStyles(r *lipgloss.Renderer) dashboardStyles {
+	return dashboardStyles{
+		title:   r.NewStyle().Bold(true).Foreground(lipgloss.Color("12")),
+		heading: r.NewStyle().Bold(true).Underline(true),
+		muted:   r.NewStyle().Foreground(lipgloss.Color("8")),
+		err:     r.NewStyle().Foreground(lipgloss.Color("9")),
+		good:    r.NewStyle().Foreground(lipgloss.Color("2")),
+		warn:    r.NewStyle().Foreground(lipgloss.Color("3")),
+		bad:     r.NewStyle().Foreground(lipgloss.Color("1")),
+	}
+}
+
+// dashboard is the model of the dashboard: it shows the classes on a date, the attendance for the current
+// semester, upcoming exams, and the results of a semester, and reloads them every refresh interval.
+type dashboard struct {
+	ctx    context.Context
+	client *amizone.Client
+	styles dashboardStyles
+	// timeout bounds each request, and refresh is the interval data is reloaded at, with 0 disabling reloads.
+	timeout time.Duration
+	refresh time.Duration
+	now     func() time.Time
+
+	date time.Time
+	// semester is the index in semesters of the semester results are shown for, with -1 standing for the current
+	// semester. It's never 0, the index of the current semester.
+	semester  int
+	semesters models.SemesterList
+
+	schedule    models.ClassSchedule
+	scheduleErr error
+	attendance  models.AttendanceRecords
+	attendErr   error
+	exams       *models.ExaminationSchedule
+	examsErr    error
+	result      *models.ExamResultRecords
+	resultErr   error
+
+	// loading is the number of loads in flight.
+	loading   int
+	refreshed time.Time
+	width     int
+}
+
+// Messages delivering the results of loads. Loads of the schedule and results carry what they were made for, so
+// that results superseded by navigating away are dropped.
+type (
+	scheduleLoaded struct {
+		date     time.Time
+		schedule models.ClassSchedule
+		err      error
+	}
+	attendanceLoaded struct {
+		attendance models.AttendanceRecords
+		err        error
+	}
+	examsLoaded struct {
+		exams *models.ExaminationSchedule
+		err   error
+	}
+	semestersLoaded struct {
+		semesters models.SemesterList
+		err       error
+	}
+	resultLoaded struct {
+		semester string
+		result   *models.ExamResultRecords
+		err      error
+	}
+	// refreshTick is sent every refresh interval.
+	refreshTick struct{}
+)
+
+func newDashboard(ctx context.Context, client *amizone.Client, renderer *lipgloss.Renderer, date time.Time) *dashboard {
+	return &dashboard{
+		ctx:      ctx,
+		client:   client,
+		styles:   newDashboardStyles(renderer),
+		timeout:  DefaultTimeout,
+		refresh:  DefaultRefreshInterval,
+		now:      time.Now,
+		date:     date,
+		semester: -1,
+	}
+}
+
+func (d *dashboard) Init() tea.Cmd {
+	return tea.Batch(d.reload(), d.scheduleRefresh())
+}
+
+func (d *dashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return d, d.handleKey(msg)
+	case tea.WindowSizeMsg:
+		d.width = msg.Width
+	case refreshTick:
+		return d, tea.Batch(d.reload(), d.scheduleRefresh())
+	case scheduleLoaded:
+		d.loaded()
+		if sameDay(msg.date, d.date) {
+			d.schedule, d.scheduleErr = msg.schedule, msg.err
+		}
+	case attendanceLoaded:
+		d.loaded()
+		d.attendance, d.attendErr = msg.attendance, msg.err
+	case examsLoaded:
+		d.loaded()
+		d.exams, d.examsErr = msg.exams, msg.err
+	case semestersLoaded:
+		d.loaded()
+		if msg.err == nil {
+			d.semesters = msg.semesters
+		}
+	case resultLoaded:
+		d.loaded()
+		if msg.semester == d.semesterRef() {
+			d.result, d.resultErr = msg.result, msg.err
+		}
+	}
+	return d, nil
+}
+
+// handleKey handles key presses, returning the loads they call for.
+func (d *dashboard) handleKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "q", "ctrl+c", "esc":
+		return tea.Quit
+	case "left", "h":
+		d.date = d.date.AddDate(0, 0, -1)
+		return d.loadSchedule()
+	case "right", "l":
+		d.date = d.date.AddDate(0, 0, 1)
+		return d.loadSchedule()
+	case "t":
+		d.date = d.now()
+		return d.loadSchedule()
+	case "[":
+		// Semesters are listed newest first, so older semesters come later. The newest is the current semester,
+		// which -1 stands for, so stepping back from it skips to the one after.
+		next := d.semester + 1
+		if next == 0 {
+			next = 1
+		}
+		if next < len(d.semesters) {
+			d.semester = next
+			return d.loadResult()
+		}
+	case "]":
+		if d.semester > 0 {
+			d.semester--
+			if d.semester == 0 {
+				d.semester = -1
+			}
+			return d.loadResult()
+		}
+	case "r":
+		return d.reload()
+	}
+	return nil
+}
+
+// reload loads everything the dashboard shows.
+func (d *dashboard) reload() tea.Cmd {
+	d.refreshed = d.now()
+	return tea.Batch(d.loadSchedule(), d.loadAttendance(), d.loadExams(), d.loadSemesters(), d.loadResult())
+}
+
+func (d *dashboard) scheduleRefresh() tea.Cmd {
+	if d.refresh <= 0 {
+		return nil
+	}
+	return tea.Tick(d.refresh, func(time.Time) tea.Msg { return refreshTick{} })
+}
+
+// load returns a command running fn with a context bounded by the timeout, counting it as in flight.
+func (d *dashboard) load(fn func(ctx context.Context) tea.Msg) tea.Cmd {
+	d.loading++
+	parent, timeout := d.ctx, d.timeout
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(parent, timeout)
+		defer cancel()
+		return fn(ctx)
+	}
+}
+
+func (d *dashboard) loaded() {
+	if d.loading > 0 {
+		d.loading--
+	}
+}
+
+func (d *dashboard) loadSchedule() tea.Cmd {
+	date := d.date
+	return d.load(func(ctx context.Context) tea.Msg {
+		schedule, err := d.client.GetClassScheduleWithContext(ctx, date.Year(), date.Month(), date.Day())
+		schedule.Sort()
+		return scheduleLoaded{date: date, schedule: schedule, err: err}
+	})
+}
+
+func (d *dashboard) loadAttendance() tea.Cmd {
+	return d.load(func(ctx context.Context) tea.Msg {
+		attendance, err := d.client.GetAttendanceWithContext(ctx)
+		return attendanceLoaded{attendance: attendance, err: err}
+	})
+}
+
+func (d *dashboard) loadExams() tea.Cmd {
+	return d.load(func(ctx context.Context) tea.Msg {
+		exams, err := d.client.GetExamScheduleWithContext(ctx)
+		return examsLoaded{exams: exams, err: err}
+	})
+}
+
+func (d *dashboard) loadSemesters() tea.Cmd {
+	return d.load(func(ctx context.Context) tea.Msg {
+		semesters, err := d.client.GetSemestersWithContext(ctx)
+		return semestersLoaded{semesters: semesters, err: err}
+	})
+}
+
+func (d *dashboard) loadResult() tea.Cmd {
+	ref := d.semesterRef()
+	return d.load(func(ctx context.Context) tea.Msg {
+		var result *models.ExamResultRecords
+		var err error
+		if ref == "" {
+			result, err = d.client.GetCurrentExaminationResultWithContext(ctx)
+		} else {
+			result, err = d.client.GetExaminationResultWithContext(ctx, ref)
+		}
+		return resultLoaded{semester: ref, result: result, err: err}
+	})
+}
+
+// semesterRef returns the ref of the semester results are shown for, or "" for the current semester.
+func (d *dashboard) semesterRef() string {
+	if d.semester < 0 || d.semester >= len(d.semesters) {
+		return ""
+	}
+	return d.semesters[d.semester].Ref
+}
+
+func (d *dashboard) View() string {
+	var b strings.Builder
+	s := d.styles
+
+	status := "updated " + d.refreshed.Format("15:04:05")
+	if d.loading > 0 {
+		status = "loading…"
+	}
+	fmt.Fprintf(&b, "%s  %s\n\n", s.title.Render("Amizone"), s.muted.Render(status))
+
+	b.WriteString(s.heading.Render("Classes on "+d.date.Format("Mon, 2 Jan 2006")) + "\n")
+	switch {
+	case d.scheduleErr != nil:
+		b.WriteString(s.err.Render(d.scheduleErr.Error()) + "\n")
+	case len(d.schedule) == 0:
+		b.WriteString(s.muted.Render("No classes") + "\n")
+	default:
+		tw := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+		for _, class := range d.schedule {
+			fmt.Fprintf(tw, "%s–%s\t%s\t%s\t%s\n", class.StartTime.Format("15:04"), class.EndTime.Format("15:04"),
+				class.Course.Code, oneLine(class.Course.Name), d.attendanceState(class.Attended))
+		}
+		_ = tw.Flush()
+	}
+
+	b.WriteString("\n" + s.heading.Render("Attendance") + "\n")
+	switch {
+	case d.attendErr != nil:
+		b.WriteString(s.err.Render(d.attendErr.Error()) + "\n")
+	case len(d.attendance) == 0:
+		b.WriteString(s.muted.Render("No attendance") + "\n")
+	default:
+		// Coloured cells go last, as their escape codes would throw off the alignment of the cells after them.
+		tw := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+		for _, record := range d.attendance {
+			style := s.good
+			if held := record.ClassesHeld; held > 0 && float64(record.ClassesAttended)*100/float64(held) < minAttendance {
+				style = s.bad
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%d/%d\t%s\n", record.Course.Code, oneLine(record.Course.Name), record.ClassesAttended,
+				record.ClassesHeld, style.Render(percent(record.ClassesAttended, record.ClassesHeld)))
+		}
+		_ = tw.Flush()
+	}
+
+	b.WriteString("\n" + s.heading.Render("Upcoming exams") + "\n")
+	upcoming := d.upcomingExams()
+	switch {
+	case d.examsErr != nil:
+		b.WriteString(s.err.Render(d.examsErr.Error()) + "\n")
+	case len(upcoming) == 0:
+		b.WriteString(s.muted.Render("No upcoming exams") + "\n")
+	default:
+		tw := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+		for _, exam := range upcoming {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", exam.Time.Format("Mon, 2 Jan 15:04"), exam.Course.Code,
+				oneLine(exam.Course.Name), s.muted.Render(oneLine(exam.Location)))
+		}
+		_ = tw.Flush()
+	}
+
+	semester := "current semester"
+	if ref := d.semesterRef(); ref != "" {
+		semester = "semester " + d.semesters[d.semester].Name
+	}
+	b.WriteString("\n" + s.heading.Render("Results, "+semester) + "\n")
+	switch {
+	case d.resultErr != nil:
+		b.WriteString(s.err.Render(d.resultErr.Error()) + "\n")
+	case d.result == nil || (len(d.result.Overall) == 0 && len(d.result.CourseWise) == 0):
+		b.WriteString(s.muted.Render("No results") + "\n")
+	default:
+		if n := len(d.result.Overall); n > 0 {
+			latest := d.result.Overall[n-1]
+			fmt.Fprintf(&b, "SGPA %.2f  CGPA %.2f  %s\n", latest.SemesterGradePointAverage,
+				latest.CumulativeGradePointAverage, s.muted.Render("(semester "+latest.Semester.Name+")"))
+		}
+		tw := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+		for _, record := range d.result.CourseWise {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", record.Course.Code, oneLine(record.Course.Name), record.Score.Grade)
+		}
+		_ = tw.Flush()
+	}
+
+	b.WriteString("\n" + s.muted.Render("←/→ date  t today  [/] semester  r refresh  q quit"))
+	if d.width > 0 {
+		return lipgloss.NewStyle().MaxWidth(d.width).Render(b.String())
+	}
+	return b.String()
+}
+
+// attendanceState renders the state of attendance for a class, coloured by state.
+func (d *dashboard) attendanceState(state models.AttendanceState) string {
+	switch state {
+	case models.AttendanceStatePresent:
+		return d.styles.good.Render("present")
+	case models.AttendanceStateAbsent:
+		return d.styles.bad.Render("absent")
+	case models.AttendanceStatePending:
+		return d.styles.warn.Render("pending")
+	}
+	return d.styles.muted.Render("n/a")
+}
+
+// upcomingExams returns the exams that haven't started yet, soonest first. Amizone's times are wall-clock times
+// in India kept as UTC, so they're compared with the wall-clock time here, taken as UTC as well.
+func (d *dashboard) upcomingExams() []models.ScheduledExam {
+	if d.exams == nil {
+		return nil
+	}
+	now := d.now()
+	wallNow := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), now.Second(), 0, time.UTC)
+	var upcoming []models.ScheduledExam
+	for _, exam := range d.exams.Exams {
+		if exam.Time.After(wallNow) {
+			upcoming = append(upcoming, exam)
+		}
+	}
+	sort.Slice(upcoming, func(i, j int) bool { return upcoming[i].Time.Before(upcoming[j].Time) })
+	return upcoming
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+// dashboardNow is the time the dashboards under test take as now.
+var dashboardNow = time.Date(2022, time.May, 15, 9, 0, 0, 0, time.UTC)
+
+// newTestDashboard returns a dashboard for the schedule of 5 Sep 2022 against server, without background refreshes.
+func newTestDashboard(t *testing.T, server *amizonetest.Server) *dashboard {
+	client, err := server.NewAmizoneClient(amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := newDashboard(context.Background(), client, lipgloss.NewRenderer(io.Discard), time.Date(2022, time.September, 5, 0, 0, 0, 0, time.Local))
+	d.refresh = 0
+	d.now = func() time.Time { return dashboardNow }
+	return d
+}
+
+// drain runs cmd and the commands that follow from the messages it produces, feeding the messages to d.
+func drain(d *dashboard, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, cmd := range msg {
+			drain(d, cmd)
+		}
+	case tea.QuitMsg:
+	default:
+		_, next := d.Update(msg)
+		drain(d, next)
+	}
+}
+
+func press(d *dashboard, key string) {
+	_, cmd := d.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	drain(d, cmd)
+}
+
+func TestDashboard(t *testing.T) {
+	g := NewWithT(t)
+	server := amizonetest.NewServer(t, amizonetest.NewScenario().WithExamSchedule(models.ExaminationSchedule{
+		Title: "End Semester Examination",
+		Exams: []models.ScheduledExam{
+			{Course: models.CourseRef{Code: "CSE202", Name: "Operating System"}, Time: dashboardNow.AddDate(0, 0, 3), Location: "E1-412"},
+			{Course: models.CourseRef{Code: "FREN144", Name: "French"}, Time: dashboardNow.AddDate(0, 0, -3)},
+		},
+	}))
+	d := newTestDashboard(t, server)
+
+	drain(d, d.Init())
+	g.Expect(d.loading).To(BeZero())
+	view := d.View()
+	g.Expect(view).To(ContainSubstring("Classes on Mon, 5 Sep 2022"))
+	g.Expect(view).To(MatchRegexp(`10:15–11:10\s+CSE208\s.*present`))
+	g.Expect(view).To(MatchRegexp(`MATH242\s+Applied Mathematics-IV\s+46/48\s+95\.83%`))
+	g.Expect(view).To(ContainSubstring("CSE202"), "upcoming exams should be shown")
+	g.Expect(view).To(MatchRegexp(`CSE202\s+Operating System\s+E1-412`))
+	g.Expect(view).ToNot(MatchRegexp(`\d{2}:\d{2}\s+FREN144`), "past exams should be left out")
+	g.Expect(view).To(ContainSubstring("Results, current semester"))
+	g.Expect(view).To(ContainSubstring("SGPA 8.46  CGPA 8.32"))
+	g.Expect(view).To(MatchRegexp(`CSE207\s+Digital Electronics and Computer Organization\s+A-`))
+	g.Expect(view).To(ContainSubstring("updated 09:00:00"))
+
+	t.Run("navigates dates", func(t *testing.T) {
+		g := NewWithT(t)
+		press(d, "l")
+		g.Expect(d.View()).To(ContainSubstring("Classes on Tue, 6 Sep 2022"))
+		press(d, "h")
+		press(d, "h")
+		g.Expect(d.View()).To(ContainSubstring("Classes on Sun, 4 Sep 2022"))
+		press(d, "t")
+		g.Expect(d.View()).To(ContainSubstring("Classes on Sun, 15 May 2022"))
+	})
+
+	t.Run("navigates semesters", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(len(d.semesters)).To(BeNumerically(">", 1))
+		// The newest semester is the current one, so the first press should show the one before it.
+		press(d, "[")
+		g.Expect(d.semester).To(Equal(1))
+		g.Expect(d.View()).To(ContainSubstring("Results, semester " + d.semesters[1].Name))
+		press(d, "]")
+		g.Expect(d.semester).To(Equal(-1))
+		g.Expect(d.View()).To(ContainSubstring("Results, current semester"))
+		for i := 0; i < len(d.semesters)+2; i++ {
+			press(d, "[")
+		}
+		g.Expect(d.semester).To(Equal(len(d.semesters)-1), "navigation should stop at the oldest semester")
+		for i := 0; i < len(d.semesters)+2; i++ {
+			press(d, "]")
+		}
+		g.Expect(d.semester).To(Equal(-1))
+		g.Expect(d.View()).To(ContainSubstring("Results, current semester"))
+	})
+
+	t.Run("drops superseded loads", func(t *testing.T) {
+		g := NewWithT(t)
+		d.Update(scheduleLoaded{date: d.date.AddDate(0, 0, 1), schedule: nil})
+		g.Expect(d.schedule).ToNot(BeEmpty())
+		d.Update(resultLoaded{semester: "1", result: &models.ExamResultRecords{}})
+		g.Expect(d.View()).To(ContainSubstring("SGPA 8.46"))
+	})
+
+	t.Run("quits", func(t *testing.T) {
+		g := NewWithT(t)
+		_, cmd := d.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+		g.Expect(cmd()).To(Equal(tea.QuitMsg{}))
+	})
+}
+
+func TestDashboard_Refresh(t *testing.T) {
+	g := NewWithT(t)
+	server := amizonetest.NewServer(t, amizonetest.NewScenario().
+		WithErrors("/Examination/ExamSchedule", http.StatusServiceUnavailable, 10))
+	d := newTestDashboard(t, server)
+
+	drain(d, d.Init())
+	g.Expect(d.View()).To(ContainSubstring("ExamSchedule: 503"), "failed loads should be shown in their section")
+	g.Expect(d.View()).To(ContainSubstring("CSE208"), "other sections should load regardless")
+
+	// Amizone recovers by the next refresh.
+	for d.examsErr != nil {
+		dashboardNow := dashboardNow.Add(time.Minute)
+		d.now = func() time.Time { return dashboardNow }
+		_, cmd := d.Update(refreshTick{})
+		drain(d, cmd)
+	}
+	g.Expect(d.View()).ToNot(ContainSubstring("ExamSchedule: 503"))
+	g.Expect(d.View()).ToNot(ContainSubstring("updated 09:00:00"))
+
+	d.refresh = time.Hour
+	g.Expect(d.scheduleRefresh()).ToNot(BeNil(), "refreshes should be scheduled with a refresh interval")
+}
+
+func TestRun_TUI(t *testing.T) {
+	g := NewWithT(t)
+	server := amizonetest.NewServer(t, nil)
+
+	var stdout strings.Builder
+	code := run(context.Background(), []string{"-amizone-url", server.URL, "tui", "-refresh", "0", "2022-09-05"},
+		strings.NewReader("q"), &stdout, io.Discard, func(key string) string { return credentialsEnv[key] })
+	g.Expect(code).To(Equal(ExitOK))
+	g.Expect(stdout.String()).To(ContainSubstring("Amizone"))
+
+	result := runCLI(t, server, credentialsEnv, "tui", "yesterday")
+	g.Expect(result.code).To(Equal(ExitUsage))
+	result = runCLI(t, server, map[string]string{}, "tui")
+	g.Expect(result.code).To(Equal(ExitAuth))
+}
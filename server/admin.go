@@ -0,0 +1,52 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+// AdminParserHealthPath is the path of the admin endpoint serving the parser health report of
+// Config.HealthMonitor as JSON. DELETE requests to it reset the report.
+const AdminParserHealthPath = "/admin/parser-health"
+
+// requireAdmin wraps next to require the bearer token configured as Config.AdminToken.
+func (s *ApiServer) requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		token := strings.TrimPrefix(header, "Bearer ")
+		if token == header || subtle.ConstantTimeCompare([]byte(token), []byte(s.config.AdminToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// serveParserHealth serves the report of Config.HealthMonitor.
+func (s *ApiServer) serveParserHealth(w http.ResponseWriter, r *http.Request) {
+	monitor := s.config.HealthMonitor
+	switch r.Method {
+	case http.MethodGet:
+		report := models.ParserHealthReport{Pages: []models.PageHealth{}}
+		if monitor != nil {
+			report = monitor.Report()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			s.config.Logger.Error(err, "Failed to write parser health report")
+		}
+	case http.MethodDelete:
+		if monitor != nil {
+			monitor.Reset()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.Header().Set("Allow", "GET, DELETE")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/models"
+)
+
+func TestAdminParserHealth(t *testing.T) {
+	g := NewWithT(t)
+	config := NewConfig("localhost:0")
+	config.Logger = logr.Discard()
+	config.AdminToken = "admin-token"
+	server := New(config)
+
+	portal := amizonetest.NewServer(t, nil)
+	client, err := portal.NewAmizoneClient(
+		amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword},
+		amizone.WithHealthMonitor(config.HealthMonitor),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = client.GetAttendance()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	request := func(method, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, AdminParserHealthPath, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	t.Run("requires the admin token", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(request(http.MethodGet, "").Code).To(Equal(http.StatusUnauthorized))
+		g.Expect(request(http.MethodGet, "not-the-admin-token").Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	t.Run("serves the report", func(t *testing.T) {
+		g := NewWithT(t)
+		response := request(http.MethodGet, config.AdminToken)
+		g.Expect(response.Code).To(Equal(http.StatusOK))
+		g.Expect(response.Header().Get("Content-Type")).To(Equal("application/json"))
+		var report models.ParserHealthReport
+		g.Expect(json.Unmarshal(response.Body.Bytes(), &report)).To(Succeed())
+		g.Expect(report.Pages).To(HaveLen(1))
+		g.Expect(report.Pages[0].Checks).To(Equal(1))
+		g.Expect(report.Healthy()).To(BeTrue())
+	})
+
+	t.Run("resets the report", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(request(http.MethodDelete, config.AdminToken).Code).To(Equal(http.StatusNoContent))
+		g.Expect(config.HealthMonitor.Report().Pages).To(BeEmpty())
+	})
+
+	t.Run("isn't served without an admin token", func(t *testing.T) {
+		g := NewWithT(t)
+		config := NewConfig("localhost:0")
+		config.Logger = logr.Discard()
+		recorder := httptest.NewRecorder()
+		New(config).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, AdminParserHealthPath, nil))
+		g.Expect(recorder.Code).To(Equal(http.StatusNotFound))
+	})
+}
@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	v1 "github.com/ditsuke/go-amizone/server/gen/go/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const authServiceLoginMethod = "/go_amizone.server.proto.v1.AuthService/Login"
+
+// DefaultMaxSessionAge is the default of how long bearer tokens can be refreshed for after logging in.
+const DefaultMaxSessionAge = 30 * 24 * time.Hour
+
+// tokenInfo is the context value for calls authenticated with a bearer token.
+type tokenInfo struct {
+	claims      *tokenClaims
+	credentials amizone.Credentials
+}
+
+// authServiceServer is an implementation of v1.AuthServiceServer, issuing the bearer tokens accepted by
+// ApiServer.authorizeCtx.
+type authServiceServer struct {
+	v1.UnimplementedAuthServiceServer
+	server *ApiServer
+}
+
+// AuthFuncOverride implements grpc_auth.ServiceAuthFuncOverride. Login is the only call that can be made
+// without authentication; the others require a bearer token, but no Amizone session.
+func (a *authServiceServer) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
+	if fullMethodName == authServiceLoginMethod {
+		return ctx, nil
+	}
+	return a.server.authorizeToken(ctx)
+}
+
+func (a *authServiceServer) Login(ctx context.Context, req *v1.LoginRequest) (*v1.AuthToken, error) {
+	if req.GetUsername() == "" || req.GetPassword() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username and password are required")
+	}
+	cred := amizone.Credentials{Username: req.GetUsername(), Password: req.GetPassword()}
+
+	// Log in rather than resume a cached session, so that tokens are only issued for credentials Amizone accepts
+	// now: a session cached for a password since changed would still be usable.
+	client, cached, err := a.server.loginAmizoneClient(ctx, cred)
+	if err != nil {
+		return nil, statusError(err, codes.Unauthenticated, "amizone: %s", err.Error())
+	}
+	// Cache the session now, so that the first call made with the token doesn't have to log in again.
+	if cached != nil {
+		a.server.syncSession(client, cached, nil)
+	}
+
+	return a.issueToken(cred)
+}
+
+func (a *authServiceServer) RefreshToken(ctx context.Context, _ *v1.EmptyMessage) (*v1.AuthToken, error) {
+	info, ok := ctx.Value(contextTokenKey).(*tokenInfo)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate")
+	}
+	maxAge := a.server.config.MaxSessionAge
+	if maxAge <= 0 {
+		maxAge = DefaultMaxSessionAge
+	}
+	if time.Since(info.claims.authTime()) > maxAge {
+		return nil, status.Errorf(codes.Unauthenticated, "session too old to be refreshed: log in again")
+	}
+
+	// Check the credentials like Login does, so that tokens stop being refreshed once the password changes.
+	client, cached, err := a.server.loginAmizoneClient(ctx, info.credentials)
+	if err != nil {
+		return nil, statusError(err, codes.Unauthenticated, "amizone: %s", err.Error())
+	}
+	if cached != nil {
+		a.server.syncSession(client, cached, nil)
+	}
+
+	token, expiry, err := a.server.tokens.reissue(info.claims, info.credentials)
+	if err != nil {
+		return nil, statusError(err, codes.Internal, "failed to issue token")
+	}
+	a.server.revokeToken(info.claims)
+	return &v1.AuthToken{Token: token, ExpiresAt: timestamppb.New(expiry)}, nil
+}
+
+func (a *authServiceServer) Logout(ctx context.Context, _ *v1.EmptyMessage) (*v1.EmptyMessage, error) {
+	info, ok := ctx.Value(contextTokenKey).(*tokenInfo)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate")
+	}
+
+	a.server.revokeToken(info.claims)
+	if a.server.config.SessionStore != nil {
+		a.server.evictSession(a.server.sessionKey(info.credentials))
+	}
+	// Logging out revokes the user's calendar feeds too, so that a leaked feed URL can be cut off.
+	if a.server.feeds != nil {
+		if err := a.server.feeds.revokeUser(info.credentials.Username); err != nil {
+			a.server.config.Logger.Error(err, "Failed to revoke calendar feeds")
+		}
+	}
+	return &v1.EmptyMessage{}, nil
+}
+
+// revokeToken revokes the token with claims, logging failures to persist the revocation.
+func (s *ApiServer) revokeToken(claims *tokenClaims) {
+	if err := s.tokens.revoke(claims); err != nil {
+		s.config.Logger.Error(err, "Failed to persist token revocation")
+	}
+}
+
+func (a *authServiceServer) issueToken(cred amizone.Credentials) (*v1.AuthToken, error) {
+	token, expiry, err := a.server.tokens.issue(cred)
+	if err != nil {
+		return nil, statusError(err, codes.Internal, "failed to issue token")
+	}
+	return &v1.AuthToken{Token: token, ExpiresAt: timestamppb.New(expiry)}, nil
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/sessionstore"
+	v1 "github.com/ditsuke/go-amizone/server/gen/go/v1"
+)
+
+func TestAuthService_Login(t *testing.T) {
+	g := NewWithT(t)
+	portal := amizonetest.NewServer(t, nil)
+	config := NewConfig("")
+	config.Logger = logr.Discard()
+	config.AmizoneBaseURL = portal.URL
+	config.RetryPolicy = amizone.RetryPolicy{}
+	server := New(config)
+	server.Init()
+	auth := &authServiceServer{server: server}
+	cred := amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword}
+	login := &v1.LoginRequest{Username: cred.Username, Password: cred.Password}
+
+	token, err := auth.Login(context.Background(), login)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(token.GetToken()).ToNot(BeEmpty())
+	_, err = config.SessionStore.Get(server.sessionKey(cred))
+	g.Expect(err).ToNot(HaveOccurred(), "the session logged in with should be cached")
+
+	// The password changes, but the cached session remains valid on Amizone. Login checks the credentials
+	// rather than resuming the session.
+	portal.Portal.AddUser(amizonetest.PortalUser{Username: cred.Username, Password: "changed"})
+	_, err = auth.Login(context.Background(), login)
+	g.Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+	_, err = config.SessionStore.Get(server.sessionKey(cred))
+	g.Expect(err).To(MatchError(sessionstore.ErrNotFound), "sessions of credentials that no longer log in should be evicted")
+}
+
+func TestAuthService_RefreshToken(t *testing.T) {
+	g := NewWithT(t)
+	portal := amizonetest.NewServer(t, nil)
+	config := NewConfig("")
+	config.Logger = logr.Discard()
+	config.AmizoneBaseURL = portal.URL
+	config.RetryPolicy = amizone.RetryPolicy{}
+	server := New(config)
+	server.Init()
+	auth := &authServiceServer{server: server}
+	cred := amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword}
+
+	login, err := auth.Login(context.Background(), &v1.LoginRequest{Username: cred.Username, Password: cred.Password})
+	g.Expect(err).ToNot(HaveOccurred())
+	// refresh refreshes token, as the call authenticated with it would.
+	refresh := func(token string) (*v1.AuthToken, error) {
+		claims, cred, err := server.tokens.verify(token)
+		g.Expect(err).ToNot(HaveOccurred())
+		ctx := context.WithValue(context.Background(), contextTokenKey, &tokenInfo{claims: claims, credentials: cred})
+		return auth.RefreshToken(ctx, &v1.EmptyMessage{})
+	}
+
+	refreshed, err := refresh(login.GetToken())
+	g.Expect(err).ToNot(HaveOccurred())
+	_, _, err = server.tokens.verify(login.GetToken())
+	g.Expect(err).To(MatchError(ErrRevokedToken), "refreshed tokens should be revoked")
+
+	t.Run("keeps the time of the login", func(t *testing.T) {
+		g := NewWithT(t)
+		authTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+		token, _, err := server.tokens.sign(cred, authTime, 0)
+		g.Expect(err).ToNot(HaveOccurred())
+		refreshed, err := refresh(token)
+		g.Expect(err).ToNot(HaveOccurred())
+		claims, _, err := server.tokens.verify(refreshed.GetToken())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(claims.AuthTime.Time).To(BeTemporally("==", authTime))
+	})
+
+	t.Run("refuses sessions older than the maximum age", func(t *testing.T) {
+		g := NewWithT(t)
+		token, _, err := server.tokens.sign(cred, time.Now().Add(-DefaultMaxSessionAge-time.Minute), 0)
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = refresh(token)
+		g.Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+	})
+
+	t.Run("refuses credentials that no longer log in", func(t *testing.T) {
+		g := NewWithT(t)
+		portal.Portal.AddUser(amizonetest.PortalUser{Username: cred.Username, Password: "changed"})
+		_, err := refresh(refreshed.GetToken())
+		g.Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+	})
+}
+
+func TestSessionKey(t *testing.T) {
+	g := NewWithT(t)
+	cred := amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword}
+	newServer := func(tokenKey string) *ApiServer {
+		config := NewConfig("")
+		config.Logger = logr.Discard()
+		config.TokenKey = []byte(tokenKey)
+		server := New(config)
+		server.Init()
+		return server
+	}
+
+	server := newServer("key")
+	g.Expect(server.sessionKey(cred)).To(Equal(newServer("key").sessionKey(cred)), "keys should survive restarts")
+	g.Expect(server.sessionKey(cred)).ToNot(Equal(server.sessionKey(amizone.Credentials{Username: cred.Username, Password: "other"})))
+	// Keys depend on the token key, so stores can't brute-force credentials from them.
+	g.Expect(server.sessionKey(cred)).ToNot(Equal(newServer("other key").sessionKey(cred)))
+}
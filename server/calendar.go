@@ -0,0 +1,373 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/ical"
+	"github.com/ditsuke/go-amizone/amizone/sessionstore"
+)
+
+// CalendarFeedsPath is the path calendar feeds are created at, by POST requests authenticated like the API, with
+// Basic auth or a bearer token. Feeds are served at CalendarFeedsPath + "/<feed token>.ics".
+const CalendarFeedsPath = "/calendar/feeds"
+
+// DefaultCalendarFeedTTL is the default lifetime of calendar feed URLs. Calendar apps are subscribed to feeds
+// once and poll them for months, so feeds outlive bearer tokens by far.
+const DefaultCalendarFeedTTL = 180 * 24 * time.Hour
+
+// The days of classes served in calendar feeds, before and after the day they're fetched on.
+const (
+	calendarFeedDaysBefore = 7
+	calendarFeedDaysAfter  = 14
+)
+
+// calendarFeedTimeout bounds the time spent fetching schedules from Amizone for a calendar feed.
+const calendarFeedTimeout = time.Minute
+
+// ist is the time zone Amizone's wall-clock times are in.
+var ist = time.FixedZone("IST", 5*60*60+30*60)
+
+// calendarFeed is the response to requests creating calendar feeds.
+type calendarFeed struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// feedGenerationKeyPrefix prefixes the SessionStore keys the feed generations of users are persisted under.
+const feedGenerationKeyPrefix = "feed-generation:"
+
+// feedAuthority issues, verifies and revokes calendar feed tokens. Feed URLs are polled for months and leak
+// easily, so they can be revoked one by one, and all the feeds of a user at once: feed tokens carry the feed
+// generation of their user, and revoking the user's feeds bumps it, so that the feeds issued before no longer verify.
+type feedAuthority struct {
+	tokens *tokenAuthority
+	ttl    time.Duration
+	// userKey keys the store keys of users' generations, so that stores never see usernames.
+	userKey []byte
+	// store persists generations, like tokenAuthority persists revocations. A nil store keeps them in memory only.
+	store sessionstore.Store
+
+	mu          sync.Mutex
+	generations map[string]feedGeneration
+}
+
+// feedGeneration is a feed generation of a user, kept until the feeds issued in it expire.
+type feedGeneration struct {
+	generation uint64
+	expiry     time.Time
+}
+
+// newFeedAuthority returns the feedAuthority issuing calendar feed tokens valid for ttl and persisting
+// revocations to store, which may be nil. Its keys are derived from the token key, so that feed tokens and
+// bearer tokens can't be used in place of each other.
+func newFeedAuthority(tokenKey []byte, ttl time.Duration, store sessionstore.Store) (*feedAuthority, error) {
+	if ttl <= 0 {
+		ttl = DefaultCalendarFeedTTL
+	}
+	deriveKey := func(purpose string) []byte {
+		mac := hmac.New(sha256.New, tokenKey)
+		mac.Write([]byte(purpose))
+		return mac.Sum(nil)
+	}
+	tokens, err := newTokenAuthority(deriveKey("calendar feeds"), ttl, store)
+	if err != nil {
+		return nil, err
+	}
+	return &feedAuthority{
+		tokens:      tokens,
+		ttl:         ttl,
+		userKey:     deriveKey("calendar feed users"),
+		store:       store,
+		generations: make(map[string]feedGeneration),
+	}, nil
+}
+
+// issue returns a new feed token for cred, in the current feed generation of the user, and the time it expires at.
+func (f *feedAuthority) issue(cred amizone.Credentials) (string, time.Time, error) {
+	key := f.generationKey(cred.Username)
+	generation, err := f.generation(key)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if generation > 0 {
+		// Keep the generation for as long as the new feed is valid, so that the feed isn't compared against an
+		// older one once it's forgotten.
+		if err := f.setGeneration(key, generation); err != nil {
+			return "", time.Time{}, err
+		}
+	}
+	return f.tokens.issueGeneration(cred, generation)
+}
+
+// verify checks that token is a feed token that's neither expired nor revoked, and returns its claims along with
+// the credentials it carries.
+func (f *feedAuthority) verify(token string) (*tokenClaims, amizone.Credentials, error) {
+	claims, cred, err := f.tokens.verify(token)
+	if err != nil {
+		return nil, amizone.Credentials{}, err
+	}
+	// Feeds are taken for revoked when the store fails, rather than for valid.
+	generation, err := f.generation(f.generationKey(cred.Username))
+	if err != nil || claims.Generation < generation {
+		return nil, amizone.Credentials{}, ErrRevokedToken
+	}
+	return claims, cred, nil
+}
+
+// revoke revokes the feed with claims.
+func (f *feedAuthority) revoke(claims *tokenClaims) error {
+	return f.tokens.revoke(claims)
+}
+
+// revokeUser revokes all the feeds issued for username so far.
+func (f *feedAuthority) revokeUser(username string) error {
+	key := f.generationKey(username)
+	generation, err := f.generation(key)
+	if err != nil {
+		return err
+	}
+	return f.setGeneration(key, generation+1)
+}
+
+// generationKey returns the store key the feed generation of username is kept under.
+func (f *feedAuthority) generationKey(username string) string {
+	mac := hmac.New(sha256.New, f.userKey)
+	mac.Write([]byte(username))
+	return feedGenerationKeyPrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// generation returns the feed generation kept under key, which is 0 for users whose feeds were never revoked.
+func (f *feedAuthority) generation(key string) (uint64, error) {
+	f.mu.Lock()
+	var generation uint64
+	if entry, ok := f.generations[key]; ok && time.Now().Before(entry.expiry) {
+		generation = entry.generation
+	}
+	f.mu.Unlock()
+	if f.store == nil {
+		return generation, nil
+	}
+	raw, err := f.store.Get(key)
+	if errors.Is(err, sessionstore.ErrNotFound) {
+		return generation, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(raw) != 8 {
+		return 0, errors.New("malformed feed generation")
+	}
+	if stored := binary.BigEndian.Uint64(raw); stored > generation {
+		generation = stored
+	}
+	return generation, nil
+}
+
+// setGeneration keeps generation under key until the feeds issued now expire. The generation is kept even if
+// persisting it fails, but only until the server restarts.
+func (f *feedAuthority) setGeneration(key string, generation uint64) error {
+	expiry := time.Now().Add(f.ttl)
+	f.mu.Lock()
+	now := time.Now()
+	// Generations are only compared against feeds issued in them, so they can be forgotten once those expire.
+	for key, entry := range f.generations {
+		if now.After(entry.expiry) {
+			delete(f.generations, key)
+		}
+	}
+	f.generations[key] = feedGeneration{generation: generation, expiry: expiry}
+	f.mu.Unlock()
+
+	if f.store == nil {
+		return nil
+	}
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, generation)
+	if store, ok := f.store.(sessionstore.ExpiringStore); ok {
+		return store.SetUntil(key, value, expiry)
+	}
+	return f.store.Set(key, value)
+}
+
+// serveCalendarFeeds creates calendar feeds for the user the request is authenticated as. Feed URLs carry the
+// user's credentials, encrypted like those of bearer tokens, so that calendar apps can poll them without
+// authenticating.
+func (s *ApiServer) serveCalendarFeeds(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	cred, ok := s.requestCredentials(r)
+	if !ok {
+		w.Header().Add("WWW-Authenticate", `Basic realm="amizone"`)
+		w.Header().Add("WWW-Authenticate", "Bearer")
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	// Check the credentials against Amizone before handing out a feed for them, like Login does.
+	client, cached, err := s.loginAmizoneClient(r.Context(), cred)
+	if err == nil && cached != nil {
+		s.syncSession(client, cached, nil)
+	}
+	if err != nil {
+		s.writeAmizoneError(w, err)
+		return
+	}
+
+	token, expiry, err := s.feeds.issue(cred)
+	if err != nil {
+		s.config.Logger.Error(err, "Failed to issue calendar feed token")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	feed := calendarFeed{
+		URL:       s.feedURL(r, token),
+		ExpiresAt: expiry.UTC(),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(feed); err != nil {
+		s.config.Logger.Error(err, "Failed to write calendar feed")
+	}
+}
+
+// feedURL returns the URL of the calendar feed with token, for the request creating it. It's built from
+// Config.PublicURL if set, and from the request otherwise.
+func (s *ApiServer) feedURL(r *http.Request, token string) string {
+	base := strings.TrimSuffix(s.config.PublicURL, "/")
+	if base == "" {
+		scheme, host := "http", r.Host
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		if s.config.TrustProxyHeaders {
+			if proto := r.Header.Get("X-Forwarded-Proto"); proto == "http" || proto == "https" {
+				scheme = proto
+			}
+			// Proxies append to X-Forwarded-Host: the first host is the one the client asked for.
+			if forwarded := strings.TrimSpace(strings.Split(r.Header.Get("X-Forwarded-Host"), ",")[0]); forwarded != "" {
+				host = forwarded
+			}
+		}
+		base = scheme + "://" + host
+	}
+	return base + CalendarFeedsPath + "/" + token + ".ics"
+}
+
+// serveCalendarFeed serves the calendar feed with the token in the request path, with the classes of the days
+// around the day it's requested on and the exam schedule. DELETE requests revoke the feed: anyone holding a feed
+// URL can read the feed, so anyone holding it can revoke it too.
+func (s *ApiServer) serveCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead && r.Method != http.MethodDelete {
+		w.Header().Set("Allow", "GET, HEAD, DELETE")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	token := strings.TrimPrefix(r.URL.Path, CalendarFeedsPath+"/")
+	token = strings.TrimSuffix(token, ".ics")
+	claims, cred, err := s.feeds.verify(token)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method == http.MethodDelete {
+		if err := s.feeds.revoke(claims); err != nil {
+			s.config.Logger.Error(err, "Failed to persist calendar feed revocation")
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), calendarFeedTimeout)
+	defer cancel()
+	client, cached, err := s.newAmizoneClient(ctx, cred)
+	if err != nil {
+		s.writeAmizoneError(w, err)
+		return
+	}
+	calendar, err := buildCalendar(ctx, client, time.Now())
+	if cached != nil {
+		s.syncSession(client, cached, err)
+	}
+	if err != nil {
+		s.writeAmizoneError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="amizone.ics"`)
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := calendar.WriteTo(w); err != nil {
+		s.config.Logger.Error(err, "Failed to write calendar feed")
+	}
+}
+
+// buildCalendar returns a calendar with the classes of the days around now, in IST, and the exam schedule.
+func buildCalendar(ctx context.Context, client amizone.ClientInterface, now time.Time) (*ical.Calendar, error) {
+	today := now.In(ist)
+	from := time.Date(today.Year(), today.Month(), today.Day()-calendarFeedDaysBefore, 0, 0, 0, 0, time.UTC)
+	to := time.Date(today.Year(), today.Month(), today.Day()+calendarFeedDaysAfter, 0, 0, 0, 0, time.UTC)
+	schedule, err := client.GetClassScheduleRangeWithContext(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	exams, err := client.GetExamScheduleWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := ical.New(ical.Options{Stamp: now})
+	calendar.AddClasses(schedule)
+	calendar.AddExams(exams)
+	return calendar, nil
+}
+
+// requestCredentials returns the credentials of a request authenticated with Basic auth or a bearer token
+// issued by AuthService.
+func (s *ApiServer) requestCredentials(r *http.Request) (amizone.Credentials, bool) {
+	if username, password, ok := r.BasicAuth(); ok {
+		return amizone.Credentials{Username: username, Password: password}, username != "" && password != ""
+	}
+	header := r.Header.Get("Authorization")
+	token := strings.TrimPrefix(header, "Bearer ")
+	if token == header {
+		return amizone.Credentials{}, false
+	}
+	_, cred, err := s.tokens.verify(token)
+	return cred, err == nil
+}
+
+// writeAmizoneError writes the response for a failure to get data from Amizone, with a status code telling
+// failures to log in apart from Amizone being unavailable, so that calendar apps know whether to try again.
+func (s *ApiServer) writeAmizoneError(w http.ResponseWriter, err error) {
+	code := http.StatusBadGateway
+	switch {
+	case amizone.IsUnavailable(err), errors.Is(err, context.DeadlineExceeded):
+		code = http.StatusServiceUnavailable
+	case errors.Is(err, amizone.ErrRateLimited):
+		code = http.StatusTooManyRequests
+	case errors.Is(err, amizone.ErrFailedLogin):
+		code = http.StatusUnauthorized
+	}
+	if code != http.StatusUnauthorized {
+		s.config.Logger.Info("Failed to serve calendar", "error", err.Error())
+	}
+	// Feeds are polled without authentication: the details of the failure stay in the log.
+	http.Error(w, http.StatusText(code), code)
+}
@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/models"
+	v1 "github.com/ditsuke/go-amizone/server/gen/go/v1"
+)
+
+func TestCalendarFeeds(t *testing.T) {
+	g := NewWithT(t)
+	today := time.Now().In(ist)
+	examTime := time.Date(today.Year(), today.Month(), today.Day()+3, 10, 0, 0, 0, time.UTC)
+	portal := amizonetest.NewServer(t, amizonetest.NewScenario().WithExamSchedule(models.ExaminationSchedule{
+		Title: "End Semester Examination",
+		Exams: []models.ScheduledExam{
+			{Course: models.CourseRef{Code: "CSE202", Name: "Operating System"}, Time: examTime, Location: "E1-412"},
+		},
+	}))
+	config := NewConfig("localhost:0")
+	config.Logger = logr.Discard()
+	config.AmizoneBaseURL = portal.URL
+	config.RetryPolicy = amizone.RetryPolicy{}
+	config.TokenKey = []byte(strings.Repeat("k", 32))
+	server := New(config)
+	server.Init()
+
+	request := func(method, target, auth string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, req)
+		return recorder
+	}
+	basicAuth := "Basic " + basicAuthValue(amizonetest.DefaultUsername, amizonetest.DefaultPassword)
+	createFeed := func(g *WithT, auth string) string {
+		response := request(http.MethodPost, CalendarFeedsPath, auth)
+		g.Expect(response.Code).To(Equal(http.StatusCreated), response.Body.String())
+		var feed calendarFeed
+		g.Expect(json.Unmarshal(response.Body.Bytes(), &feed)).To(Succeed())
+		g.Expect(feed.ExpiresAt).To(BeTemporally("~", time.Now().Add(DefaultCalendarFeedTTL), time.Minute))
+		feedURL, err := url.Parse(feed.URL)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(feedURL.Host).To(Equal("example.com"), "feed URLs should point back at the server")
+		return feedURL.Path
+	}
+
+	feedPath := createFeed(g, basicAuth)
+	g.Expect(feedPath).To(HavePrefix(CalendarFeedsPath + "/"))
+	g.Expect(feedPath).To(HaveSuffix(".ics"))
+	g.Expect(feedPath).ToNot(ContainSubstring(amizonetest.DefaultPassword))
+
+	t.Run("serves the feed", func(t *testing.T) {
+		g := NewWithT(t)
+		response := request(http.MethodGet, feedPath, "")
+		g.Expect(response.Code).To(Equal(http.StatusOK), response.Body.String())
+		g.Expect(response.Header().Get("Content-Type")).To(Equal("text/calendar; charset=utf-8"))
+		feed := response.Body.String()
+		g.Expect(feed).To(HavePrefix("BEGIN:VCALENDAR\r\n"))
+		// The fake portal repeats the classes of its fixture every day.
+		for _, day := range []int{-calendarFeedDaysBefore, 0, calendarFeedDaysAfter} {
+			date := time.Date(today.Year(), today.Month(), today.Day()+day, 0, 0, 0, 0, time.UTC)
+			g.Expect(feed).To(ContainSubstring("DTSTART;TZID=Asia/Kolkata:"+date.Format("20060102")+"T"),
+				"the feed should have the classes of %s", date.Format("2 Jan"))
+		}
+		date := time.Date(today.Year(), today.Month(), today.Day()+calendarFeedDaysAfter+1, 0, 0, 0, 0, time.UTC)
+		g.Expect(feed).ToNot(ContainSubstring("DTSTART;TZID=Asia/Kolkata:" + date.Format("20060102") + "T"))
+		g.Expect(feed).To(ContainSubstring("UID:exam-CSE202-" + examTime.Format("20060102T150405") + "@go-amizone\r\n"))
+		g.Expect(feed).To(ContainSubstring("LOCATION:E1-412\r\n"))
+	})
+
+	t.Run("creates feeds for bearer tokens", func(t *testing.T) {
+		g := NewWithT(t)
+		token, _, err := server.tokens.issue(amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword})
+		g.Expect(err).ToNot(HaveOccurred())
+		path := createFeed(g, "Bearer "+token)
+		g.Expect(request(http.MethodGet, path, "").Code).To(Equal(http.StatusOK))
+
+		// Bearer tokens don't work as feed tokens, nor the other way around.
+		g.Expect(request(http.MethodGet, CalendarFeedsPath+"/"+token+".ics", "").Code).To(Equal(http.StatusNotFound))
+		feedToken := strings.TrimSuffix(strings.TrimPrefix(path, CalendarFeedsPath+"/"), ".ics")
+		g.Expect(request(http.MethodPost, CalendarFeedsPath, "Bearer "+feedToken).Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	t.Run("requires credentials that log in", func(t *testing.T) {
+		g := NewWithT(t)
+		response := request(http.MethodPost, CalendarFeedsPath, "")
+		g.Expect(response.Code).To(Equal(http.StatusUnauthorized))
+		g.Expect(response.Header().Values("WWW-Authenticate")).To(HaveLen(2))
+		g.Expect(request(http.MethodPost, CalendarFeedsPath, "Basic "+basicAuthValue("nobody", "wrong")).Code).
+			To(Equal(http.StatusUnauthorized))
+		g.Expect(request(http.MethodGet, CalendarFeedsPath, basicAuth).Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	t.Run("builds feed URLs", func(t *testing.T) {
+		feedURL := func(g *WithT, headers map[string]string) string {
+			req := httptest.NewRequest(http.MethodPost, CalendarFeedsPath, nil)
+			req.Header.Set("Authorization", basicAuth)
+			for name, value := range headers {
+				req.Header.Set(name, value)
+			}
+			response := httptest.NewRecorder()
+			server.ServeHTTP(response, req)
+			g.Expect(response.Code).To(Equal(http.StatusCreated), response.Body.String())
+			var feed calendarFeed
+			g.Expect(json.Unmarshal(response.Body.Bytes(), &feed)).To(Succeed())
+			return feed.URL
+		}
+		forwarded := map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "proxy.example.com, other.example.com"}
+
+		t.Run("ignoring proxy headers by default", func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(feedURL(g, forwarded)).To(HavePrefix("http://example.com" + CalendarFeedsPath + "/"))
+		})
+
+		t.Run("from trusted proxy headers", func(t *testing.T) {
+			g := NewWithT(t)
+			config.TrustProxyHeaders = true
+			defer func() { config.TrustProxyHeaders = false }()
+			g.Expect(feedURL(g, forwarded)).To(HavePrefix("https://proxy.example.com" + CalendarFeedsPath + "/"))
+		})
+
+		t.Run("from the public URL", func(t *testing.T) {
+			g := NewWithT(t)
+			config.PublicURL = "https://amizone.example.com/"
+			config.TrustProxyHeaders = true
+			defer func() { config.PublicURL, config.TrustProxyHeaders = "", false }()
+			feed := feedURL(g, forwarded)
+			g.Expect(feed).To(HavePrefix("https://amizone.example.com" + CalendarFeedsPath + "/"))
+			path := strings.TrimPrefix(feed, "https://amizone.example.com")
+			g.Expect(request(http.MethodGet, path, "").Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	t.Run("rejects bad feed tokens", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(request(http.MethodGet, CalendarFeedsPath+"/not-a-token.ics", "").Code).To(Equal(http.StatusNotFound))
+		g.Expect(request(http.MethodPost, feedPath, "").Code).To(Equal(http.StatusMethodNotAllowed))
+		g.Expect(request(http.MethodDelete, CalendarFeedsPath+"/not-a-token.ics", "").Code).To(Equal(http.StatusNotFound))
+	})
+
+	t.Run("revokes feeds", func(t *testing.T) {
+		g := NewWithT(t)
+		path := createFeed(g, basicAuth)
+		g.Expect(request(http.MethodDelete, path, "").Code).To(Equal(http.StatusNoContent))
+		g.Expect(request(http.MethodGet, path, "").Code).To(Equal(http.StatusNotFound))
+		g.Expect(request(http.MethodGet, feedPath, "").Code).To(Equal(http.StatusOK), "other feeds should be left alone")
+	})
+
+	t.Run("revokes the feeds of users who log out", func(t *testing.T) {
+		g := NewWithT(t)
+		cred := amizone.Credentials{Username: amizonetest.DefaultUsername, Password: amizonetest.DefaultPassword}
+		before := createFeed(g, basicAuth)
+		token, _, err := server.tokens.issue(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+		claims, _, err := server.tokens.verify(token)
+		g.Expect(err).ToNot(HaveOccurred())
+		ctx := context.WithValue(context.Background(), contextTokenKey, &tokenInfo{claims: claims, credentials: cred})
+		_, err = (&authServiceServer{server: server}).Logout(ctx, &v1.EmptyMessage{})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(request(http.MethodGet, before, "").Code).To(Equal(http.StatusNotFound))
+		g.Expect(request(http.MethodGet, feedPath, "").Code).To(Equal(http.StatusNotFound))
+		after := createFeed(g, basicAuth)
+		g.Expect(request(http.MethodGet, after, "").Code).To(Equal(http.StatusOK), "feeds created after logging out should work")
+
+		// Revocations are persisted through the session store, for servers sharing it.
+		restarted := New(config)
+		restarted.Init()
+		for path, code := range map[string]int{before: http.StatusNotFound, after: http.StatusOK} {
+			recorder := httptest.NewRecorder()
+			restarted.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+			g.Expect(recorder.Code).To(Equal(code))
+		}
+	})
+}
+
+func TestCalendarFeeds_NoTokenKey(t *testing.T) {
+	g := NewWithT(t)
+	config := NewConfig("localhost:0")
+	config.Logger = logr.Discard()
+	server := New(config)
+
+	req := httptest.NewRequest(http.MethodPost, CalendarFeedsPath, nil)
+	req.SetBasicAuth(amizonetest.DefaultUsername, amizonetest.DefaultPassword)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, req)
+	g.Expect(recorder.Code).To(Equal(http.StatusNotFound), "feeds shouldn't be served with a key that doesn't survive restarts")
+}
+
+func TestCalendarFeeds_AmizoneDown(t *testing.T) {
+	g := NewWithT(t)
+	portal := amizonetest.NewServer(t, nil)
+	config := NewConfig("localhost:0")
+	config.Logger = logr.Discard()
+	config.AmizoneBaseURL = portal.URL
+	config.RetryPolicy = amizone.RetryPolicy{}
+	config.SessionStore = nil
+	config.TokenKey = []byte(strings.Repeat("k", 32))
+	server := New(config)
+
+	req := httptest.NewRequest(http.MethodPost, CalendarFeedsPath, nil)
+	req.SetBasicAuth(amizonetest.DefaultUsername, amizonetest.DefaultPassword)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, req)
+	g.Expect(recorder.Code).To(Equal(http.StatusCreated))
+	var feed calendarFeed
+	g.Expect(json.Unmarshal(recorder.Body.Bytes(), &feed)).To(Succeed())
+
+	downPortal := amizonetest.NewServer(t, amizonetest.NewScenario().WithErrors("/", http.StatusServiceUnavailable, -1))
+	config.AmizoneBaseURL = downPortal.URL
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, feed.URL, nil))
+	g.Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable), "calendar apps should be told to try again later")
+	g.Expect(recorder.Body.String()).To(Equal(http.StatusText(http.StatusServiceUnavailable)+"\n"),
+		"the details of failures shouldn't be served to anyone polling the feed")
+}
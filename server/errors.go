@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// serviceError is a gRPC status error that retains the error that caused it, so that interceptors can
+// inspect the cause through errors.Is and errors.As while clients only see the status.
+type serviceError struct {
+	status *status.Status
+	cause  error
+}
+
+// statusError returns a gRPC status error with the code and formatted message passed, wrapping cause.
+func statusError(cause error, c codes.Code, format string, a ...any) error {
+	return &serviceError{status: status.New(c, fmt.Sprintf(format, a...)), cause: cause}
+}
+
+func (e *serviceError) Error() string {
+	return e.status.Err().Error()
+}
+
+// GRPCStatus makes serviceError usable with status.FromError and, transitively, the gRPC server.
+func (e *serviceError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+func (e *serviceError) Unwrap() error {
+	return e.cause
+}
+
+// amizoneErrorInterceptor is a grpc.UnaryServerInterceptor that reports failures caused by Amizone being down
+// or unreachable with codes.Unavailable, and failures caused by the server's limits with codes.ResourceExhausted,
+// so that clients can tell them apart from other failures and retry later.
+func amizoneErrorInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	switch {
+	case err == nil:
+	case amizone.IsUnavailable(err):
+		return resp, statusError(err, codes.Unavailable, "amizone is unavailable: %s", status.Convert(err).Message())
+	case errors.Is(err, amizone.ErrRateLimited):
+		return resp, statusError(err, codes.ResourceExhausted, "too many requests: %s", status.Convert(err).Message())
+	}
+	return resp, err
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAmizoneErrorInterceptor(t *testing.T) {
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "circuit open",
+			err:          statusError(fmt.Errorf("fetch: %w", amizone.ErrCircuitOpen), codes.Internal, "failed"),
+			expectedCode: codes.Unavailable,
+		},
+		{
+			name:         "amizone unreachable",
+			err:          statusError(fmt.Errorf("%w: dial tcp: timeout", amizone.ErrFailedToVisitPage), codes.Unknown, "failed"),
+			expectedCode: codes.Unavailable,
+		},
+		{
+			name:         "amizone erroring",
+			err:          statusError(&amizone.HTTPError{StatusCode: http.StatusServiceUnavailable}, codes.Internal, "failed"),
+			expectedCode: codes.Unavailable,
+		},
+		{
+			name:         "rate limited",
+			err:          statusError(fmt.Errorf("%w: context deadline exceeded", amizone.ErrRateLimited), codes.Unknown, "failed"),
+			expectedCode: codes.ResourceExhausted,
+		},
+		{
+			name:         "not found",
+			err:          statusError(&amizone.HTTPError{StatusCode: http.StatusNotFound}, codes.Internal, "failed"),
+			expectedCode: codes.Internal,
+		},
+		{
+			name:         "unrelated error",
+			err:          status.Error(codes.InvalidArgument, "bad request"),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "plain error",
+			err:          errors.New("failed"),
+			expectedCode: codes.Unknown,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			handler := func(context.Context, any) (any, error) {
+				return nil, testCase.err
+			}
+			_, err := amizoneErrorInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+			g.Expect(status.Code(err)).To(Equal(testCase.expectedCode))
+			g.Expect(errors.Is(err, testCase.err)).To(BeTrue(), "the original error should be retained")
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the gateway tests")
+
+// goldenDir holds the golden files of TestGateway, one per route call.
+const goldenDir = "testdata/gateway"
+
+// scrubbedFields are fields of gateway responses that change from run to run, and are replaced with
+// scrubbedValue in golden files.
+var scrubbedFields = []string{"token", "expiresAt"}
+
+const scrubbedValue = "<scrubbed>"
+
+// gatewayCall is a call to a REST route of the gateway, whose response is compared to a golden file.
+type gatewayCall struct {
+	name   string
+	method string
+	path   string
+	body   string
+	// auth is the value of the Authorization header. Empty values are replaced with the Basic auth header of
+	// the fake portal's default user.
+	auth string
+}
+
+// TestGateway runs the ApiServer against a fake Amizone, calls every REST route of the gateway and compares the
+// responses to golden files in goldenDir. Run with -update to rewrite the golden files, and review the diff.
+func TestGateway(t *testing.T) {
+	g := NewWithT(t)
+	portal := amizonetest.NewServer(t, nil)
+	baseURL := startGatewayServer(t, portal.URL)
+	basicAuth := "Basic " + basicAuthValue(amizonetest.DefaultUsername, amizonetest.DefaultPassword)
+
+	// Calls are made in order: the mutations change what the fake portal serves, and the auth calls use the
+	// tokens of the calls before them.
+	calls := []gatewayCall{
+		{name: "attendance", method: http.MethodGet, path: "/api/v1/attendance"},
+		{name: "class_schedule", method: http.MethodGet, path: "/api/v1/class_schedule/2022/9/5"},
+		{name: "class_schedule_range", method: http.MethodGet, path: "/api/v1/class_schedule/2022/9/5/to/2022/9/6"},
+		{name: "class_schedule_range_invalid", method: http.MethodGet, path: "/api/v1/class_schedule/2022/9/6/to/2022/9/5"},
+		{name: "exam_schedule", method: http.MethodGet, path: "/api/v1/exam_schedule"},
+		{name: "semesters", method: http.MethodGet, path: "/api/v1/semesters"},
+		{name: "courses", method: http.MethodGet, path: "/api/v1/courses"},
+		{name: "courses_semester", method: http.MethodGet, path: "/api/v1/courses/1"},
+		{name: "exam_result", method: http.MethodGet, path: "/api/v1/exam_result"},
+		{name: "exam_result_semester", method: http.MethodGet, path: "/api/v1/exam_result/1"},
+		{name: "user_profile", method: http.MethodGet, path: "/api/v1/user_profile"},
+		{name: "wifi_mac", method: http.MethodGet, path: "/api/v1/wifi_mac"},
+		{
+			name:   "wifi_mac_register",
+			method: http.MethodPost,
+			path:   "/api/v1/wifi_mac",
+			body:   `{"address": "aa:bb:cc:dd:ee:ff", "override_limit": true}`,
+		},
+		{name: "wifi_mac_registered", method: http.MethodGet, path: "/api/v1/wifi_mac"},
+		{name: "wifi_mac_deregister", method: http.MethodDelete, path: "/api/v1/wifi_mac/aa:bb:cc:dd:ee:ff"},
+		{
+			name:   "faculty_feedback",
+			method: http.MethodPost,
+			path:   "/api/v1/faculty/feedback/submit",
+			body:   `{"rating": 5, "query_rating": 3, "comment": "Great teaching"}`,
+		},
+		{name: "unauthenticated", method: http.MethodGet, path: "/api/v1/attendance", auth: "Basic " + basicAuthValue("nobody", "wrong")},
+	}
+	for _, call := range calls {
+		if call.auth == "" {
+			call.auth = basicAuth
+		}
+		checkGolden(t, call.name, doGatewayCall(t, baseURL, call))
+	}
+
+	// The auth routes are called in a chain: refresh the token issued by login, then log out the refreshed token.
+	login := doGatewayCall(t, baseURL, gatewayCall{
+		method: http.MethodPost,
+		path:   "/api/v1/auth/login",
+		body:   `{"username": "` + amizonetest.DefaultUsername + `", "password": "` + amizonetest.DefaultPassword + `"}`,
+	})
+	token := gatewayToken(g, login)
+	refresh := doGatewayCall(t, baseURL, gatewayCall{method: http.MethodPost, path: "/api/v1/auth/refresh", auth: "Bearer " + token})
+	refreshedToken := gatewayToken(g, refresh)
+	logout := doGatewayCall(t, baseURL, gatewayCall{method: http.MethodPost, path: "/api/v1/auth/logout", auth: "Bearer " + refreshedToken})
+	loggedOut := doGatewayCall(t, baseURL, gatewayCall{method: http.MethodGet, path: "/api/v1/attendance", auth: "Bearer " + refreshedToken})
+	checkGolden(t, "auth_login", login)
+	checkGolden(t, "auth_refresh", refresh)
+	checkGolden(t, "auth_logout", logout)
+	checkGolden(t, "auth_logged_out", loggedOut)
+}
+
+// gatewayResponse is what golden files hold of a response: its status code and JSON body.
+type gatewayResponse struct {
+	Status int         `json:"status"`
+	Body   interface{} `json:"body"`
+}
+
+// startGatewayServer starts an ApiServer talking to the Amizone at amizoneURL, and returns its base URL. The
+// gateway dials the gRPC server on the port it's bound to, so the server listens on a real port.
+func startGatewayServer(t *testing.T, amizoneURL string) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("find a free port: %s", err)
+	}
+	addr := listener.Addr().String()
+	_ = listener.Close()
+
+	config := NewConfig(addr)
+	config.Logger = logr.Discard()
+	config.AmizoneBaseURL = amizoneURL
+	server := New(config)
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			t.Errorf("serve: %s", err)
+		}
+	}()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Stop(ctx)
+	})
+
+	// The gateway dials the gRPC server when the server starts, and backs off when that fails, so wait until it
+	// gets through: unauthenticated calls fail with 401 rather than 503 then. The services share the gateway's
+	// connection, so the auth routes are through once this one is.
+	for deadline := time.Now().Add(10 * time.Second); ; {
+		response, err := http.Get("http://" + addr + "/api/v1/semesters")
+		if err == nil {
+			_ = response.Body.Close()
+			if response.StatusCode != http.StatusServiceUnavailable {
+				break
+			}
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("gateway didn't get through to the gRPC server on %s", addr)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return "http://" + addr
+}
+
+// doGatewayCall makes call to the gateway at baseURL and returns its response.
+func doGatewayCall(t *testing.T, baseURL string, call gatewayCall) gatewayResponse {
+	t.Helper()
+	var body io.Reader
+	if call.body != "" {
+		body = strings.NewReader(call.body)
+	}
+	req, err := http.NewRequest(call.method, baseURL+call.path, body)
+	if err != nil {
+		t.Fatalf("%s: build request: %s", call.name, err)
+	}
+	if call.auth != "" {
+		req.Header.Set("Authorization", call.auth)
+	}
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s: %s %s: %s", call.name, call.method, call.path, err)
+	}
+	defer response.Body.Close()
+	raw, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("%s: read response: %s", call.name, err)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("%s: response isn't JSON: %s: %q", call.name, err, raw)
+	}
+	return gatewayResponse{Status: response.StatusCode, Body: decoded}
+}
+
+// scrub replaces the values of scrubbedFields in v, a decoded JSON value, with scrubbedValue.
+func scrub(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = scrub(value)
+			for _, field := range scrubbedFields {
+				if key == field {
+					v[key] = scrubbedValue
+				}
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = scrub(v[i])
+		}
+	}
+	return v
+}
+
+// gatewayToken returns the token of an auth response.
+func gatewayToken(g *WithT, response gatewayResponse) string {
+	g.Expect(response.Status).To(Equal(http.StatusOK), "auth call failed: %v", response.Body)
+	body, ok := response.Body.(map[string]interface{})
+	g.Expect(ok).To(BeTrue())
+	token, ok := body["token"].(string)
+	g.Expect(ok).To(BeTrue())
+	return token
+}
+
+// checkGolden compares response, with scrubbedFields scrubbed, to the golden file for name, or rewrites the file
+// if -update is set.
+func checkGolden(t *testing.T, name string, response gatewayResponse) {
+	t.Helper()
+	response.Body = scrub(response.Body)
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(response); err != nil {
+		t.Fatalf("%s: encode response: %s", name, err)
+	}
+	got := buf.Bytes()
+	path := filepath.Join(goldenDir, name+".json")
+	if *updateGolden {
+		if err := os.MkdirAll(goldenDir, 0o755); err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("%s: write golden file: %s", name, err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%s: read golden file (run with -update to create it): %s", name, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s: response doesn't match %s (run with -update to update it):\ngot:\n%s\nwant:\n%s", name, path, got, want)
+	}
+}
+
+func basicAuthValue(username, password string) string {
+	return base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+}
@@ -117,6 +117,117 @@ func (*EmptyMessage) Descriptor() ([]byte, []int) {
 	return file_v1_amizone_proto_rawDescGZIP(), []int{0}
 }
 
+type LoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_amizone_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_amizone_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_v1_amizone_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// AuthToken is a bearer token, to be passed in the "Authorization" header as "Bearer <token>".
+type AuthToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *AuthToken) Reset() {
+	*x = AuthToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_amizone_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthToken) ProtoMessage() {}
+
+func (x *AuthToken) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_amizone_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthToken.ProtoReflect.Descriptor instead.
+func (*AuthToken) Descriptor() ([]byte, []int) {
+	return file_v1_amizone_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuthToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AuthToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type ClassScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -128,7 +239,7 @@ type ClassScheduleRequest struct {
 func (x *ClassScheduleRequest) Reset() {
 	*x = ClassScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[1]
+		mi := &file_v1_amizone_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -141,7 +252,7 @@ func (x *ClassScheduleRequest) String() string {
 func (*ClassScheduleRequest) ProtoMessage() {}
 
 func (x *ClassScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[1]
+	mi := &file_v1_amizone_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,7 +265,7 @@ func (x *ClassScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClassScheduleRequest.ProtoReflect.Descriptor instead.
 func (*ClassScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{1}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ClassScheduleRequest) GetDate() *date.Date {
@@ -164,6 +275,61 @@ func (x *ClassScheduleRequest) GetDate() *date.Date {
 	return nil
 }
 
+type ClassScheduleRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start *date.Date `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   *date.Date `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *ClassScheduleRangeRequest) Reset() {
+	*x = ClassScheduleRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_amizone_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClassScheduleRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassScheduleRangeRequest) ProtoMessage() {}
+
+func (x *ClassScheduleRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_amizone_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassScheduleRangeRequest.ProtoReflect.Descriptor instead.
+func (*ClassScheduleRangeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_amizone_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClassScheduleRangeRequest) GetStart() *date.Date {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ClassScheduleRangeRequest) GetEnd() *date.Date {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
 type CourseRef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -176,7 +342,7 @@ type CourseRef struct {
 func (x *CourseRef) Reset() {
 	*x = CourseRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[2]
+		mi := &file_v1_amizone_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -189,7 +355,7 @@ func (x *CourseRef) String() string {
 func (*CourseRef) ProtoMessage() {}
 
 func (x *CourseRef) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[2]
+	mi := &file_v1_amizone_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -202,7 +368,7 @@ func (x *CourseRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CourseRef.ProtoReflect.Descriptor instead.
 func (*CourseRef) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{2}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CourseRef) GetCode() string {
@@ -232,7 +398,7 @@ type SemesterRef struct {
 func (x *SemesterRef) Reset() {
 	*x = SemesterRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[3]
+		mi := &file_v1_amizone_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -245,7 +411,7 @@ func (x *SemesterRef) String() string {
 func (*SemesterRef) ProtoMessage() {}
 
 func (x *SemesterRef) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[3]
+	mi := &file_v1_amizone_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +424,7 @@ func (x *SemesterRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SemesterRef.ProtoReflect.Descriptor instead.
 func (*SemesterRef) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{3}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SemesterRef) GetSemesterRef() string {
@@ -281,7 +447,7 @@ type Attendance struct {
 func (x *Attendance) Reset() {
 	*x = Attendance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[4]
+		mi := &file_v1_amizone_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -294,7 +460,7 @@ func (x *Attendance) String() string {
 func (*Attendance) ProtoMessage() {}
 
 func (x *Attendance) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[4]
+	mi := &file_v1_amizone_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +473,7 @@ func (x *Attendance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
 func (*Attendance) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{4}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Attendance) GetAttended() int32 {
@@ -338,7 +504,7 @@ type Marks struct {
 func (x *Marks) Reset() {
 	*x = Marks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[5]
+		mi := &file_v1_amizone_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -351,7 +517,7 @@ func (x *Marks) String() string {
 func (*Marks) ProtoMessage() {}
 
 func (x *Marks) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[5]
+	mi := &file_v1_amizone_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +530,7 @@ func (x *Marks) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Marks.ProtoReflect.Descriptor instead.
 func (*Marks) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{5}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Marks) GetHave() float32 {
@@ -396,7 +562,7 @@ type ExamResultRecord struct {
 func (x *ExamResultRecord) Reset() {
 	*x = ExamResultRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[6]
+		mi := &file_v1_amizone_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -409,7 +575,7 @@ func (x *ExamResultRecord) String() string {
 func (*ExamResultRecord) ProtoMessage() {}
 
 func (x *ExamResultRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[6]
+	mi := &file_v1_amizone_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +588,7 @@ func (x *ExamResultRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExamResultRecord.ProtoReflect.Descriptor instead.
 func (*ExamResultRecord) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{6}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ExamResultRecord) GetCourse() *CourseRef {
@@ -467,7 +633,7 @@ type Score struct {
 func (x *Score) Reset() {
 	*x = Score{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[7]
+		mi := &file_v1_amizone_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -480,7 +646,7 @@ func (x *Score) String() string {
 func (*Score) ProtoMessage() {}
 
 func (x *Score) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[7]
+	mi := &file_v1_amizone_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +659,7 @@ func (x *Score) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Score.ProtoReflect.Descriptor instead.
 func (*Score) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{7}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Score) GetMax() int32 {
@@ -531,7 +697,7 @@ type Credits struct {
 func (x *Credits) Reset() {
 	*x = Credits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[8]
+		mi := &file_v1_amizone_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -544,7 +710,7 @@ func (x *Credits) String() string {
 func (*Credits) ProtoMessage() {}
 
 func (x *Credits) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[8]
+	mi := &file_v1_amizone_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -557,7 +723,7 @@ func (x *Credits) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Credits.ProtoReflect.Descriptor instead.
 func (*Credits) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{8}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Credits) GetAcquired() int32 {
@@ -596,7 +762,7 @@ type OverallResult struct {
 func (x *OverallResult) Reset() {
 	*x = OverallResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[9]
+		mi := &file_v1_amizone_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -609,7 +775,7 @@ func (x *OverallResult) String() string {
 func (*OverallResult) ProtoMessage() {}
 
 func (x *OverallResult) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[9]
+	mi := &file_v1_amizone_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +788,7 @@ func (x *OverallResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OverallResult.ProtoReflect.Descriptor instead.
 func (*OverallResult) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{9}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *OverallResult) GetSemester() *SemesterRef {
@@ -660,7 +826,7 @@ type ExamResultRecords struct {
 func (x *ExamResultRecords) Reset() {
 	*x = ExamResultRecords{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[10]
+		mi := &file_v1_amizone_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -673,7 +839,7 @@ func (x *ExamResultRecords) String() string {
 func (*ExamResultRecords) ProtoMessage() {}
 
 func (x *ExamResultRecords) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[10]
+	mi := &file_v1_amizone_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,7 +852,7 @@ func (x *ExamResultRecords) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExamResultRecords.ProtoReflect.Descriptor instead.
 func (*ExamResultRecords) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{10}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ExamResultRecords) GetCourseWise() []*ExamResultRecord {
@@ -719,7 +885,7 @@ type Course struct {
 func (x *Course) Reset() {
 	*x = Course{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[11]
+		mi := &file_v1_amizone_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -732,7 +898,7 @@ func (x *Course) String() string {
 func (*Course) ProtoMessage() {}
 
 func (x *Course) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[11]
+	mi := &file_v1_amizone_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +911,7 @@ func (x *Course) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Course.ProtoReflect.Descriptor instead.
 func (*Course) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{11}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Course) GetRef() *CourseRef {
@@ -795,7 +961,7 @@ type Courses struct {
 func (x *Courses) Reset() {
 	*x = Courses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[12]
+		mi := &file_v1_amizone_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -808,7 +974,7 @@ func (x *Courses) String() string {
 func (*Courses) ProtoMessage() {}
 
 func (x *Courses) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[12]
+	mi := &file_v1_amizone_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +987,7 @@ func (x *Courses) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Courses.ProtoReflect.Descriptor instead.
 func (*Courses) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{12}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Courses) GetCourses() []*Course {
@@ -844,7 +1010,7 @@ type AttendanceRecord struct {
 func (x *AttendanceRecord) Reset() {
 	*x = AttendanceRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[13]
+		mi := &file_v1_amizone_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -857,7 +1023,7 @@ func (x *AttendanceRecord) String() string {
 func (*AttendanceRecord) ProtoMessage() {}
 
 func (x *AttendanceRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[13]
+	mi := &file_v1_amizone_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,7 +1036,7 @@ func (x *AttendanceRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttendanceRecord.ProtoReflect.Descriptor instead.
 func (*AttendanceRecord) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{13}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AttendanceRecord) GetAttendance() *Attendance {
@@ -900,7 +1066,7 @@ type AttendanceRecords struct {
 func (x *AttendanceRecords) Reset() {
 	*x = AttendanceRecords{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[14]
+		mi := &file_v1_amizone_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -913,7 +1079,7 @@ func (x *AttendanceRecords) String() string {
 func (*AttendanceRecords) ProtoMessage() {}
 
 func (x *AttendanceRecords) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[14]
+	mi := &file_v1_amizone_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,7 +1092,7 @@ func (x *AttendanceRecords) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttendanceRecords.ProtoReflect.Descriptor instead.
 func (*AttendanceRecords) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{14}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AttendanceRecords) GetRecords() []*AttendanceRecord {
@@ -953,7 +1119,7 @@ type ScheduledClass struct {
 func (x *ScheduledClass) Reset() {
 	*x = ScheduledClass{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[15]
+		mi := &file_v1_amizone_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -966,7 +1132,7 @@ func (x *ScheduledClass) String() string {
 func (*ScheduledClass) ProtoMessage() {}
 
 func (x *ScheduledClass) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[15]
+	mi := &file_v1_amizone_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,7 +1145,7 @@ func (x *ScheduledClass) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduledClass.ProtoReflect.Descriptor instead.
 func (*ScheduledClass) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{15}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ScheduledClass) GetCourse() *CourseRef {
@@ -1036,7 +1202,7 @@ type ScheduledClasses struct {
 func (x *ScheduledClasses) Reset() {
 	*x = ScheduledClasses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[16]
+		mi := &file_v1_amizone_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1049,7 +1215,7 @@ func (x *ScheduledClasses) String() string {
 func (*ScheduledClasses) ProtoMessage() {}
 
 func (x *ScheduledClasses) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[16]
+	mi := &file_v1_amizone_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +1228,7 @@ func (x *ScheduledClasses) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduledClasses.ProtoReflect.Descriptor instead.
 func (*ScheduledClasses) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{16}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ScheduledClasses) GetClasses() []*ScheduledClass {
@@ -1090,7 +1256,7 @@ type AmizoneDiaryEvent struct {
 func (x *AmizoneDiaryEvent) Reset() {
 	*x = AmizoneDiaryEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[17]
+		mi := &file_v1_amizone_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1103,7 +1269,7 @@ func (x *AmizoneDiaryEvent) String() string {
 func (*AmizoneDiaryEvent) ProtoMessage() {}
 
 func (x *AmizoneDiaryEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[17]
+	mi := &file_v1_amizone_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,7 +1282,7 @@ func (x *AmizoneDiaryEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AmizoneDiaryEvent.ProtoReflect.Descriptor instead.
 func (*AmizoneDiaryEvent) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{17}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *AmizoneDiaryEvent) GetType() string {
@@ -1182,7 +1348,7 @@ type ScheduledExam struct {
 func (x *ScheduledExam) Reset() {
 	*x = ScheduledExam{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[18]
+		mi := &file_v1_amizone_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1195,7 +1361,7 @@ func (x *ScheduledExam) String() string {
 func (*ScheduledExam) ProtoMessage() {}
 
 func (x *ScheduledExam) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[18]
+	mi := &file_v1_amizone_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1208,7 +1374,7 @@ func (x *ScheduledExam) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduledExam.ProtoReflect.Descriptor instead.
 func (*ScheduledExam) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{18}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ScheduledExam) GetCourse() *CourseRef {
@@ -1251,7 +1417,7 @@ type ExaminationSchedule struct {
 func (x *ExaminationSchedule) Reset() {
 	*x = ExaminationSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[19]
+		mi := &file_v1_amizone_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1264,7 +1430,7 @@ func (x *ExaminationSchedule) String() string {
 func (*ExaminationSchedule) ProtoMessage() {}
 
 func (x *ExaminationSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[19]
+	mi := &file_v1_amizone_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1277,7 +1443,7 @@ func (x *ExaminationSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExaminationSchedule.ProtoReflect.Descriptor instead.
 func (*ExaminationSchedule) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{19}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ExaminationSchedule) GetTitle() string {
@@ -1314,7 +1480,7 @@ type Profile struct {
 func (x *Profile) Reset() {
 	*x = Profile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[20]
+		mi := &file_v1_amizone_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1327,7 +1493,7 @@ func (x *Profile) String() string {
 func (*Profile) ProtoMessage() {}
 
 func (x *Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[20]
+	mi := &file_v1_amizone_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,7 +1506,7 @@ func (x *Profile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Profile.ProtoReflect.Descriptor instead.
 func (*Profile) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{20}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Profile) GetName() string {
@@ -1421,7 +1587,7 @@ type Semester struct {
 func (x *Semester) Reset() {
 	*x = Semester{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[21]
+		mi := &file_v1_amizone_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1434,7 +1600,7 @@ func (x *Semester) String() string {
 func (*Semester) ProtoMessage() {}
 
 func (x *Semester) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[21]
+	mi := &file_v1_amizone_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,7 +1613,7 @@ func (x *Semester) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Semester.ProtoReflect.Descriptor instead.
 func (*Semester) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{21}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Semester) GetName() string {
@@ -1475,7 +1641,7 @@ type SemesterList struct {
 func (x *SemesterList) Reset() {
 	*x = SemesterList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[22]
+		mi := &file_v1_amizone_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1488,7 +1654,7 @@ func (x *SemesterList) String() string {
 func (*SemesterList) ProtoMessage() {}
 
 func (x *SemesterList) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[22]
+	mi := &file_v1_amizone_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1501,7 +1667,7 @@ func (x *SemesterList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SemesterList.ProtoReflect.Descriptor instead.
 func (*SemesterList) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{22}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SemesterList) GetSemesters() []*Semester {
@@ -1524,7 +1690,7 @@ type WifiMacInfo struct {
 func (x *WifiMacInfo) Reset() {
 	*x = WifiMacInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[23]
+		mi := &file_v1_amizone_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1537,7 +1703,7 @@ func (x *WifiMacInfo) String() string {
 func (*WifiMacInfo) ProtoMessage() {}
 
 func (x *WifiMacInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[23]
+	mi := &file_v1_amizone_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1550,7 +1716,7 @@ func (x *WifiMacInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WifiMacInfo.ProtoReflect.Descriptor instead.
 func (*WifiMacInfo) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{23}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *WifiMacInfo) GetAddresses() []string {
@@ -1585,7 +1751,7 @@ type DeregisterWifiMacRequest struct {
 func (x *DeregisterWifiMacRequest) Reset() {
 	*x = DeregisterWifiMacRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[24]
+		mi := &file_v1_amizone_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1598,7 +1764,7 @@ func (x *DeregisterWifiMacRequest) String() string {
 func (*DeregisterWifiMacRequest) ProtoMessage() {}
 
 func (x *DeregisterWifiMacRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[24]
+	mi := &file_v1_amizone_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,7 +1777,7 @@ func (x *DeregisterWifiMacRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeregisterWifiMacRequest.ProtoReflect.Descriptor instead.
 func (*DeregisterWifiMacRequest) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{24}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DeregisterWifiMacRequest) GetAddress() string {
@@ -1633,7 +1799,7 @@ type RegisterWifiMacRequest struct {
 func (x *RegisterWifiMacRequest) Reset() {
 	*x = RegisterWifiMacRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[25]
+		mi := &file_v1_amizone_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1646,7 +1812,7 @@ func (x *RegisterWifiMacRequest) String() string {
 func (*RegisterWifiMacRequest) ProtoMessage() {}
 
 func (x *RegisterWifiMacRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[25]
+	mi := &file_v1_amizone_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,7 +1825,7 @@ func (x *RegisterWifiMacRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterWifiMacRequest.ProtoReflect.Descriptor instead.
 func (*RegisterWifiMacRequest) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{25}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *RegisterWifiMacRequest) GetAddress() string {
@@ -1689,7 +1855,7 @@ type FillFacultyFeedbackRequest struct {
 func (x *FillFacultyFeedbackRequest) Reset() {
 	*x = FillFacultyFeedbackRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[26]
+		mi := &file_v1_amizone_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1702,7 +1868,7 @@ func (x *FillFacultyFeedbackRequest) String() string {
 func (*FillFacultyFeedbackRequest) ProtoMessage() {}
 
 func (x *FillFacultyFeedbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[26]
+	mi := &file_v1_amizone_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1715,7 +1881,7 @@ func (x *FillFacultyFeedbackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FillFacultyFeedbackRequest.ProtoReflect.Descriptor instead.
 func (*FillFacultyFeedbackRequest) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{26}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *FillFacultyFeedbackRequest) GetRating() int32 {
@@ -1750,7 +1916,7 @@ type FillFacultyFeedbackResponse struct {
 func (x *FillFacultyFeedbackResponse) Reset() {
 	*x = FillFacultyFeedbackResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_amizone_proto_msgTypes[27]
+		mi := &file_v1_amizone_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1763,7 +1929,7 @@ func (x *FillFacultyFeedbackResponse) String() string {
 func (*FillFacultyFeedbackResponse) ProtoMessage() {}
 
 func (x *FillFacultyFeedbackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_amizone_proto_msgTypes[27]
+	mi := &file_v1_amizone_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1776,7 +1942,7 @@ func (x *FillFacultyFeedbackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FillFacultyFeedbackResponse.ProtoReflect.Descriptor instead.
 func (*FillFacultyFeedbackResponse) Descriptor() ([]byte, []int) {
-	return file_v1_amizone_proto_rawDescGZIP(), []int{27}
+	return file_v1_amizone_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *FillFacultyFeedbackResponse) GetFilledFor() int32 {
@@ -1801,380 +1967,444 @@ var file_v1_amizone_proto_rawDesc = []byte{
 	0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3d, 0x0a, 0x14, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x65, 0x22, 0x33, 0x0a, 0x09, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
-	0x66, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x0b, 0x53, 0x65, 0x6d,
-	0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6d, 0x65,
-	0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x66, 0x22, 0x3c, 0x0a, 0x0a, 0x41,
-	0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74,
-	0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74,
-	0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x22, 0x2d, 0x0a, 0x05, 0x4d, 0x61, 0x72,
-	0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x04, 0x68, 0x61, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0xff, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x61,
-	0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3d, 0x0a,
-	0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5c, 0x0a,
+	0x09, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x3d, 0x0a, 0x14, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x44, 0x61, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x69, 0x0a, 0x19, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x23, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x33, 0x0a, 0x09, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x0b, 0x53, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6d,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x66, 0x22, 0x3c, 0x0a, 0x0a,
+	0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x22, 0x2d, 0x0a, 0x05, 0x4d, 0x61,
+	0x72, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x04, 0x68, 0x61, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0xff, 0x01, 0x0a, 0x10, 0x45, 0x78,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3d,
+	0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67,
+	0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69,
+	0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0b,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x61, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x05, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x5b, 0x0a,
+	0x07, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x63, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x0d, 0x4f,
+	0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x43, 0x0a, 0x08,
+	0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x66, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x19, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x12, 0x43, 0x0a, 0x1e, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1b, 0x63, 0x75, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x4d, 0x0a,
+	0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x77, 0x69, 0x73, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x57, 0x69, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07,
+	0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
 	0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f,
-	0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a,
-	0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x07, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x61, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x05, 0x53, 0x63,
-	0x6f, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x07,
-	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x0d, 0x4f, 0x76,
-	0x65, 0x72, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x73,
-	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x22, 0x8a, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x03,
+	0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x61,
+	0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x66,
+	0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x74, 0x74,
+	0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
 	0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x65, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x66, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
-	0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x19, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
-	0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67,
-	0x65, 0x12, 0x43, 0x0a, 0x1e, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x76, 0x65, 0x72,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1b, 0x63, 0x75, 0x6d, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x41,
-	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x61, 0x6d, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x4d, 0x0a, 0x0b,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x77, 0x69, 0x73, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x57, 0x69, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x6f,
-	0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67,
-	0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x61, 0x6c,
-	0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c,
-	0x22, 0x8a, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x03, 0x72,
-	0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d,
-	0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x66, 0x52,
-	0x03, 0x72, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x65,
-	0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67,
-	0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x48, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d,
-	0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x0d, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x79,
-	0x6c, 0x6c, 0x61, 0x62, 0x75, 0x73, 0x5f, 0x64, 0x6f, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x73, 0x79, 0x6c, 0x6c, 0x61, 0x62, 0x75, 0x73, 0x44, 0x6f, 0x63, 0x22, 0x47, 0x0a,
-	0x07, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x61,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e,
+	0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x48, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x61,
 	0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x41, 0x74, 0x74, 0x65, 0x6e,
-	0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x61,
-	0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74,
-	0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x0d, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x79, 0x6c, 0x6c, 0x61, 0x62, 0x75, 0x73, 0x5f, 0x64, 0x6f, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x79, 0x6c, 0x6c, 0x61, 0x62, 0x75, 0x73, 0x44, 0x6f, 0x63, 0x22, 0x47,
+	0x0a, 0x07, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x5f,
+	0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x41, 0x74, 0x74, 0x65,
+	0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x46, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74,
+	0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x5f, 0x61,
+	0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x22, 0xbc, 0x02, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
 	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x22, 0x5b, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d,
-	0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22,
-	0xbc, 0x02, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
-	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f,
-	0x6d, 0x12, 0x4b, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f,
-	0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x58,
-	0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x65, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52,
-	0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x41, 0x6d, 0x69,
-	0x7a, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x61, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18,
 	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x12,
 	0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
-	0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x0d, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x3d, 0x0a, 0x06,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67,
-	0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d,
-	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
-	0x1f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6c, 0x0a,
-	0x13, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x65, 0x78,
-	0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x61,
-	0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
-	0x45, 0x78, 0x61, 0x6d, 0x52, 0x05, 0x65, 0x78, 0x61, 0x6d, 0x73, 0x22, 0xe2, 0x02, 0x0a, 0x07,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x13, 0x65, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x12, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66,
-	0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66,
-	0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x6f, 0x64, 0x5f, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x6f,
-	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x64, 0x5f, 0x63, 0x61, 0x72,
-	0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x69, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x22, 0x30, 0x0a, 0x08, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72,
-	0x65, 0x66, 0x22, 0x52, 0x0a, 0x0c, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f,
-	0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x09, 0x73, 0x65, 0x6d,
-	0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x60, 0x0a, 0x0b, 0x57, 0x69, 0x66, 0x69, 0x4d, 0x61,
-	0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x65,
-	0x65, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66,
-	0x72, 0x65, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x34, 0x0a, 0x18, 0x44, 0x65, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x66, 0x69, 0x4d, 0x61, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x59,
-	0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x66, 0x69, 0x4d, 0x61,
-	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72,
-	0x72, 0x69, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x71, 0x0a, 0x1a, 0x46, 0x69, 0x6c,
-	0x6c, 0x46, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x21, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3c, 0x0a, 0x1b,
-	0x46, 0x69, 0x6c, 0x6c, 0x46, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64, 0x62,
-	0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
-	0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x2a, 0x4c, 0x0a, 0x0f, 0x41, 0x74,
-	0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a,
-	0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52,
-	0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x42, 0x53, 0x45, 0x4e,
-	0x54, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x41, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x49,
-	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x04, 0x32, 0xe0, 0x0e, 0x0a, 0x0e, 0x41, 0x6d, 0x69,
-	0x7a, 0x6f, 0x6e, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x2e,
+	0x6f, 0x6d, 0x12, 0x4b, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a,
+	0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x58, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x41, 0x6d,
+	0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x61, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x6f, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x0d,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x3d, 0x0a,
+	0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
 	0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69,
-	0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0xb6, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x30, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69,
-	0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x6f, 0x5f, 0x61,
-	0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
-	0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12,
-	0x3a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x79, 0x65,
-	0x61, 0x72, 0x7d, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x7d,
-	0x2f, 0x7b, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x64, 0x61, 0x79, 0x7d, 0x12, 0x8b, 0x01, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
-	0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x2f, 0x2e, 0x67, 0x6f, 0x5f, 0x61,
-	0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d,
-	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x7d, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61,
-	0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x19, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69,
-	0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x66,
-	0x1a, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b,
-	0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x7d, 0x12, 0x7b, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x73, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6c,
+	0x0a, 0x13, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x65,
+	0x78, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x6f, 0x5f,
+	0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x05, 0x65, 0x78, 0x61, 0x6d, 0x73, 0x22, 0xe2, 0x02, 0x0a,
+	0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x13, 0x65, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x12, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f,
+	0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x6f, 0x64, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f,
+	0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x64, 0x5f, 0x63, 0x61,
+	0x72, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x69, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x22, 0x30, 0x0a, 0x08, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x72, 0x65, 0x66, 0x22, 0x52, 0x0a, 0x0c, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a,
+	0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x09, 0x73, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x60, 0x0a, 0x0b, 0x57, 0x69, 0x66, 0x69, 0x4d,
+	0x61, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72,
+	0x65, 0x65, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x66, 0x72, 0x65, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x34, 0x0a, 0x18, 0x44, 0x65, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x66, 0x69, 0x4d, 0x61, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x59, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x66, 0x69, 0x4d,
+	0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x71, 0x0a, 0x1a, 0x46, 0x69,
+	0x6c, 0x6c, 0x46, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3c, 0x0a,
+	0x1b, 0x46, 0x69, 0x6c, 0x6c, 0x46, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x2a, 0x4c, 0x0a, 0x0f, 0x41,
+	0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50,
+	0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x42, 0x53, 0x45,
+	0x4e, 0x54, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x41, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x04, 0x32, 0x85, 0x03, 0x0a, 0x0b, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7c, 0x0a, 0x05, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e,
 	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x23, 0x2e, 0x67,
-	0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x73, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x27, 0x2e, 0x67,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67,
 	0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x66, 0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f,
-	0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x2f, 0x7b, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x7d,
-	0x12, 0x8c, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x45,
-	0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61,
-	0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x7d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x23, 0x2e, 0x67, 0x6f,
-	0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x7d,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x66, 0x69, 0x4d, 0x61, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x22, 0x92, 0x41, 0x02, 0x62, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74,
+	0x68, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x7d, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69,
+	0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x79, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
 	0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x27, 0x2e, 0x67, 0x6f, 0x5f,
+	0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f,
 	0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x66, 0x69, 0x4d, 0x61, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69, 0x66, 0x69, 0x5f, 0x6d, 0x61, 0x63, 0x12, 0x8c, 0x01,
-	0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x66, 0x69, 0x4d, 0x61,
-	0x63, 0x12, 0x32, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x66, 0x69, 0x4d, 0x61, 0x63, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x13, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x6c, 0x6f, 0x67, 0x6f, 0x75,
+	0x74, 0x32, 0xca, 0x10, 0x0a, 0x0e, 0x41, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a,
+	0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74,
+	0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x12, 0xb6, 0x01, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x30, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73,
+	0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x2f, 0x7b, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x79, 0x65, 0x61, 0x72, 0x7d, 0x2f, 0x7b, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x7d, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x64, 0x61, 0x79, 0x7d, 0x12, 0xe7, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x35,
+	0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f,
 	0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69, 0x66, 0x69, 0x5f, 0x6d, 0x61, 0x63, 0x12, 0x97, 0x01, 0x0a,
-	0x11, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x66, 0x69, 0x4d,
-	0x61, 0x63, 0x12, 0x34, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x65, 0x73, 0x22, 0x69, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x63, 0x12, 0x61, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x79, 0x65, 0x61, 0x72, 0x7d,
+	0x2f, 0x7b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x7d, 0x2f, 0x7b,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x64, 0x61, 0x79, 0x7d, 0x2f, 0x74, 0x6f, 0x2f, 0x7b, 0x65,
+	0x6e, 0x64, 0x2e, 0x79, 0x65, 0x61, 0x72, 0x7d, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x7d, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x2e, 0x64, 0x61, 0x79, 0x7d, 0x12, 0x8b,
+	0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e,
 	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x66, 0x69, 0x4d, 0x61,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x2f, 0x2e, 0x67,
+	0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x1d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x78, 0x61, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x7d, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x67,
+	0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a,
+	0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x5f,
+	0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x66, 0x1a, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
+	0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x2f, 0x7b, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x7d,
+	0x12, 0x7b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f,
+	0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x93, 0x01,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x66, 0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d,
+	0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12,
+	0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x2f, 0x7b, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x66, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x2e, 0x67,
+	0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a,
+	0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x7d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x23,
+	0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x7d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x66, 0x69, 0x4d, 0x61, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x27, 0x2e,
+	0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x66, 0x69, 0x4d,
+	0x61, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69, 0x66, 0x69, 0x5f, 0x6d, 0x61, 0x63,
+	0x12, 0x8c, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x66,
+	0x69, 0x4d, 0x61, 0x63, 0x12, 0x32, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x66, 0x69, 0x4d, 0x61,
 	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d,
 	0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69, 0x66, 0x69, 0x5f, 0x6d, 0x61, 0x63, 0x2f, 0x7b, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xb2, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6c, 0x6c, 0x46,
-	0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x36,
-	0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c,
-	0x46, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a,
-	0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x46, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x46,
-	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x66, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x2f, 0x66, 0x65, 0x65, 0x64,
-	0x62, 0x61, 0x63, 0x6b, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0xe2, 0x03, 0x5a, 0x32,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x74, 0x73, 0x75,
-	0x6b, 0x65, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x5f,
-	0x76, 0x31, 0x92, 0x41, 0xaa, 0x03, 0x12, 0x8b, 0x01, 0x0a, 0x0b, 0x41, 0x6d, 0x69, 0x7a, 0x6f,
-	0x6e, 0x65, 0x20, 0x41, 0x50, 0x49, 0x22, 0x31, 0x0a, 0x07, 0x64, 0x69, 0x74, 0x73, 0x75, 0x6b,
-	0x65, 0x12, 0x13, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x64, 0x69, 0x74, 0x73, 0x75,
-	0x6b, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x1a, 0x11, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x40, 0x64, 0x69,
-	0x74, 0x73, 0x75, 0x6b, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2a, 0x42, 0x0a, 0x07, 0x47, 0x50, 0x4c,
-	0x2d, 0x32, 0x2e, 0x30, 0x12, 0x37, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x74, 0x73, 0x75, 0x6b, 0x65,
-	0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
-	0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x32, 0x05, 0x30,
-	0x2e, 0x37, 0x2e, 0x30, 0x1a, 0x0f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x66, 0x6c,
-	0x79, 0x2e, 0x64, 0x65, 0x76, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x50, 0x0a,
-	0x03, 0x34, 0x30, 0x33, 0x12, 0x49, 0x0a, 0x47, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
-	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x64,
-	0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x70, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x5a,
-	0x3b, 0x0a, 0x39, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x2c,
-	0x08, 0x01, 0x12, 0x28, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x61, 0x75, 0x74, 0x68, 0x20, 0x63,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73,
-	0x2e, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x65, 0x64, 0x75, 0x62, 0x12, 0x0a, 0x10,
-	0x0a, 0x09, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x03, 0x0a, 0x01, 0x2a,
-	0x72, 0x3e, 0x0a, 0x15, 0x4d, 0x6f, 0x72, 0x65, 0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x67,
-	0x6f, 0x2d, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x25, 0x68, 0x74, 0x74, 0x70, 0x73,
-	0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69,
+	0x67, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69, 0x66, 0x69, 0x5f, 0x6d, 0x61, 0x63, 0x12,
+	0x97, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69,
+	0x66, 0x69, 0x4d, 0x61, 0x63, 0x12, 0x34, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f,
+	0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x66,
+	0x69, 0x4d, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f,
+	0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69, 0x66, 0x69, 0x5f, 0x6d, 0x61, 0x63, 0x2f,
+	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xb2, 0x01, 0x0a, 0x13, 0x46, 0x69,
+	0x6c, 0x6c, 0x46, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x12, 0x36, 0x2e, 0x67, 0x6f, 0x5f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6c, 0x6c, 0x46, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x67, 0x6f, 0x5f, 0x61,
+	0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x46, 0x61, 0x63, 0x75, 0x6c,
+	0x74, 0x79, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x61, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x2f, 0x66,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0xd9,
+	0x04, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69,
 	0x74, 0x73, 0x75, 0x6b, 0x65, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x5f, 0x76, 0x31, 0x92, 0x41, 0xa1, 0x04, 0x12, 0x8b, 0x01, 0x0a, 0x0b, 0x41, 0x6d,
+	0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x20, 0x41, 0x50, 0x49, 0x22, 0x31, 0x0a, 0x07, 0x64, 0x69, 0x74,
+	0x73, 0x75, 0x6b, 0x65, 0x12, 0x13, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x64, 0x69,
+	0x74, 0x73, 0x75, 0x6b, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x1a, 0x11, 0x68, 0x65, 0x6c, 0x6c, 0x6f,
+	0x40, 0x64, 0x69, 0x74, 0x73, 0x75, 0x6b, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2a, 0x42, 0x0a, 0x07,
+	0x47, 0x50, 0x4c, 0x2d, 0x32, 0x2e, 0x30, 0x12, 0x37, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x74, 0x73,
+	0x75, 0x6b, 0x65, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2f, 0x62,
+	0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45,
+	0x32, 0x05, 0x30, 0x2e, 0x37, 0x2e, 0x30, 0x1a, 0x0f, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65,
+	0x2e, 0x66, 0x6c, 0x79, 0x2e, 0x64, 0x65, 0x76, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x52, 0x50, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x49, 0x0a, 0x47, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65,
+	0x72, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x5a, 0x9f, 0x01, 0x0a, 0x39, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x2c, 0x08, 0x01, 0x12, 0x28, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x61, 0x75,
+	0x74, 0x68, 0x20, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x73, 0x2e, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x2e, 0x65, 0x64, 0x75,
+	0x0a, 0x62, 0x0a, 0x0a, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x54,
+	0x08, 0x02, 0x12, 0x3f, 0x41, 0x20, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x41, 0x75, 0x74,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x2c, 0x20,
+	0x61, 0x73, 0x20, 0x22, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x3c, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x3e, 0x22, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x02, 0x62, 0x12, 0x0a, 0x10, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x03, 0x0a, 0x01, 0x2a, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x72, 0x3e, 0x0a, 0x15, 0x4d, 0x6f,
+	0x72, 0x65, 0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x67, 0x6f, 0x2d, 0x61, 0x6d, 0x69, 0x7a,
+	0x6f, 0x6e, 0x65, 0x12, 0x25, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x74, 0x73, 0x75, 0x6b, 0x65, 0x2f,
+	0x67, 0x6f, 0x2d, 0x61, 0x6d, 0x69, 0x7a, 0x6f, 0x6e, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -2190,98 +2420,112 @@ func file_v1_amizone_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_amizone_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_amizone_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_v1_amizone_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_v1_amizone_proto_goTypes = []interface{}{
 	(AttendanceState)(0),                // 0: go_amizone.server.proto.v1.AttendanceState
 	(*EmptyMessage)(nil),                // 1: go_amizone.server.proto.v1.EmptyMessage
-	(*ClassScheduleRequest)(nil),        // 2: go_amizone.server.proto.v1.ClassScheduleRequest
-	(*CourseRef)(nil),                   // 3: go_amizone.server.proto.v1.CourseRef
-	(*SemesterRef)(nil),                 // 4: go_amizone.server.proto.v1.SemesterRef
-	(*Attendance)(nil),                  // 5: go_amizone.server.proto.v1.Attendance
-	(*Marks)(nil),                       // 6: go_amizone.server.proto.v1.Marks
-	(*ExamResultRecord)(nil),            // 7: go_amizone.server.proto.v1.ExamResultRecord
-	(*Score)(nil),                       // 8: go_amizone.server.proto.v1.Score
-	(*Credits)(nil),                     // 9: go_amizone.server.proto.v1.Credits
-	(*OverallResult)(nil),               // 10: go_amizone.server.proto.v1.OverallResult
-	(*ExamResultRecords)(nil),           // 11: go_amizone.server.proto.v1.ExamResultRecords
-	(*Course)(nil),                      // 12: go_amizone.server.proto.v1.Course
-	(*Courses)(nil),                     // 13: go_amizone.server.proto.v1.Courses
-	(*AttendanceRecord)(nil),            // 14: go_amizone.server.proto.v1.AttendanceRecord
-	(*AttendanceRecords)(nil),           // 15: go_amizone.server.proto.v1.AttendanceRecords
-	(*ScheduledClass)(nil),              // 16: go_amizone.server.proto.v1.ScheduledClass
-	(*ScheduledClasses)(nil),            // 17: go_amizone.server.proto.v1.ScheduledClasses
-	(*AmizoneDiaryEvent)(nil),           // 18: go_amizone.server.proto.v1.AmizoneDiaryEvent
-	(*ScheduledExam)(nil),               // 19: go_amizone.server.proto.v1.ScheduledExam
-	(*ExaminationSchedule)(nil),         // 20: go_amizone.server.proto.v1.ExaminationSchedule
-	(*Profile)(nil),                     // 21: go_amizone.server.proto.v1.Profile
-	(*Semester)(nil),                    // 22: go_amizone.server.proto.v1.Semester
-	(*SemesterList)(nil),                // 23: go_amizone.server.proto.v1.SemesterList
-	(*WifiMacInfo)(nil),                 // 24: go_amizone.server.proto.v1.WifiMacInfo
-	(*DeregisterWifiMacRequest)(nil),    // 25: go_amizone.server.proto.v1.DeregisterWifiMacRequest
-	(*RegisterWifiMacRequest)(nil),      // 26: go_amizone.server.proto.v1.RegisterWifiMacRequest
-	(*FillFacultyFeedbackRequest)(nil),  // 27: go_amizone.server.proto.v1.FillFacultyFeedbackRequest
-	(*FillFacultyFeedbackResponse)(nil), // 28: go_amizone.server.proto.v1.FillFacultyFeedbackResponse
-	(*date.Date)(nil),                   // 29: google.type.Date
-	(*timestamppb.Timestamp)(nil),       // 30: google.protobuf.Timestamp
+	(*LoginRequest)(nil),                // 2: go_amizone.server.proto.v1.LoginRequest
+	(*AuthToken)(nil),                   // 3: go_amizone.server.proto.v1.AuthToken
+	(*ClassScheduleRequest)(nil),        // 4: go_amizone.server.proto.v1.ClassScheduleRequest
+	(*ClassScheduleRangeRequest)(nil),   // 5: go_amizone.server.proto.v1.ClassScheduleRangeRequest
+	(*CourseRef)(nil),                   // 6: go_amizone.server.proto.v1.CourseRef
+	(*SemesterRef)(nil),                 // 7: go_amizone.server.proto.v1.SemesterRef
+	(*Attendance)(nil),                  // 8: go_amizone.server.proto.v1.Attendance
+	(*Marks)(nil),                       // 9: go_amizone.server.proto.v1.Marks
+	(*ExamResultRecord)(nil),            // 10: go_amizone.server.proto.v1.ExamResultRecord
+	(*Score)(nil),                       // 11: go_amizone.server.proto.v1.Score
+	(*Credits)(nil),                     // 12: go_amizone.server.proto.v1.Credits
+	(*OverallResult)(nil),               // 13: go_amizone.server.proto.v1.OverallResult
+	(*ExamResultRecords)(nil),           // 14: go_amizone.server.proto.v1.ExamResultRecords
+	(*Course)(nil),                      // 15: go_amizone.server.proto.v1.Course
+	(*Courses)(nil),                     // 16: go_amizone.server.proto.v1.Courses
+	(*AttendanceRecord)(nil),            // 17: go_amizone.server.proto.v1.AttendanceRecord
+	(*AttendanceRecords)(nil),           // 18: go_amizone.server.proto.v1.AttendanceRecords
+	(*ScheduledClass)(nil),              // 19: go_amizone.server.proto.v1.ScheduledClass
+	(*ScheduledClasses)(nil),            // 20: go_amizone.server.proto.v1.ScheduledClasses
+	(*AmizoneDiaryEvent)(nil),           // 21: go_amizone.server.proto.v1.AmizoneDiaryEvent
+	(*ScheduledExam)(nil),               // 22: go_amizone.server.proto.v1.ScheduledExam
+	(*ExaminationSchedule)(nil),         // 23: go_amizone.server.proto.v1.ExaminationSchedule
+	(*Profile)(nil),                     // 24: go_amizone.server.proto.v1.Profile
+	(*Semester)(nil),                    // 25: go_amizone.server.proto.v1.Semester
+	(*SemesterList)(nil),                // 26: go_amizone.server.proto.v1.SemesterList
+	(*WifiMacInfo)(nil),                 // 27: go_amizone.server.proto.v1.WifiMacInfo
+	(*DeregisterWifiMacRequest)(nil),    // 28: go_amizone.server.proto.v1.DeregisterWifiMacRequest
+	(*RegisterWifiMacRequest)(nil),      // 29: go_amizone.server.proto.v1.RegisterWifiMacRequest
+	(*FillFacultyFeedbackRequest)(nil),  // 30: go_amizone.server.proto.v1.FillFacultyFeedbackRequest
+	(*FillFacultyFeedbackResponse)(nil), // 31: go_amizone.server.proto.v1.FillFacultyFeedbackResponse
+	(*timestamppb.Timestamp)(nil),       // 32: google.protobuf.Timestamp
+	(*date.Date)(nil),                   // 33: google.type.Date
 }
 var file_v1_amizone_proto_depIdxs = []int32{
-	29, // 0: go_amizone.server.proto.v1.ClassScheduleRequest.date:type_name -> google.type.Date
-	3,  // 1: go_amizone.server.proto.v1.ExamResultRecord.course:type_name -> go_amizone.server.proto.v1.CourseRef
-	8,  // 2: go_amizone.server.proto.v1.ExamResultRecord.score:type_name -> go_amizone.server.proto.v1.Score
-	9,  // 3: go_amizone.server.proto.v1.ExamResultRecord.credits:type_name -> go_amizone.server.proto.v1.Credits
-	29, // 4: go_amizone.server.proto.v1.ExamResultRecord.publish_date:type_name -> google.type.Date
-	4,  // 5: go_amizone.server.proto.v1.OverallResult.semester:type_name -> go_amizone.server.proto.v1.SemesterRef
-	7,  // 6: go_amizone.server.proto.v1.ExamResultRecords.course_wise:type_name -> go_amizone.server.proto.v1.ExamResultRecord
-	10, // 7: go_amizone.server.proto.v1.ExamResultRecords.overall:type_name -> go_amizone.server.proto.v1.OverallResult
-	3,  // 8: go_amizone.server.proto.v1.Course.ref:type_name -> go_amizone.server.proto.v1.CourseRef
-	5,  // 9: go_amizone.server.proto.v1.Course.attendance:type_name -> go_amizone.server.proto.v1.Attendance
-	6,  // 10: go_amizone.server.proto.v1.Course.internal_marks:type_name -> go_amizone.server.proto.v1.Marks
-	12, // 11: go_amizone.server.proto.v1.Courses.courses:type_name -> go_amizone.server.proto.v1.Course
-	5,  // 12: go_amizone.server.proto.v1.AttendanceRecord.attendance:type_name -> go_amizone.server.proto.v1.Attendance
-	3,  // 13: go_amizone.server.proto.v1.AttendanceRecord.course:type_name -> go_amizone.server.proto.v1.CourseRef
-	14, // 14: go_amizone.server.proto.v1.AttendanceRecords.records:type_name -> go_amizone.server.proto.v1.AttendanceRecord
-	3,  // 15: go_amizone.server.proto.v1.ScheduledClass.course:type_name -> go_amizone.server.proto.v1.CourseRef
-	30, // 16: go_amizone.server.proto.v1.ScheduledClass.start_time:type_name -> google.protobuf.Timestamp
-	30, // 17: go_amizone.server.proto.v1.ScheduledClass.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 18: go_amizone.server.proto.v1.ScheduledClass.attendance:type_name -> go_amizone.server.proto.v1.AttendanceState
-	16, // 19: go_amizone.server.proto.v1.ScheduledClasses.classes:type_name -> go_amizone.server.proto.v1.ScheduledClass
-	3,  // 20: go_amizone.server.proto.v1.ScheduledExam.course:type_name -> go_amizone.server.proto.v1.CourseRef
-	30, // 21: go_amizone.server.proto.v1.ScheduledExam.time:type_name -> google.protobuf.Timestamp
-	19, // 22: go_amizone.server.proto.v1.ExaminationSchedule.exams:type_name -> go_amizone.server.proto.v1.ScheduledExam
-	30, // 23: go_amizone.server.proto.v1.Profile.enrollment_validity:type_name -> google.protobuf.Timestamp
-	30, // 24: go_amizone.server.proto.v1.Profile.date_of_birth:type_name -> google.protobuf.Timestamp
-	22, // 25: go_amizone.server.proto.v1.SemesterList.semesters:type_name -> go_amizone.server.proto.v1.Semester
-	1,  // 26: go_amizone.server.proto.v1.AmizoneService.GetAttendance:input_type -> go_amizone.server.proto.v1.EmptyMessage
-	2,  // 27: go_amizone.server.proto.v1.AmizoneService.GetClassSchedule:input_type -> go_amizone.server.proto.v1.ClassScheduleRequest
-	1,  // 28: go_amizone.server.proto.v1.AmizoneService.GetExamSchedule:input_type -> go_amizone.server.proto.v1.EmptyMessage
-	1,  // 29: go_amizone.server.proto.v1.AmizoneService.GetSemesters:input_type -> go_amizone.server.proto.v1.EmptyMessage
-	4,  // 30: go_amizone.server.proto.v1.AmizoneService.GetCourses:input_type -> go_amizone.server.proto.v1.SemesterRef
-	1,  // 31: go_amizone.server.proto.v1.AmizoneService.GetCurrentCourses:input_type -> go_amizone.server.proto.v1.EmptyMessage
-	4,  // 32: go_amizone.server.proto.v1.AmizoneService.GetExamResult:input_type -> go_amizone.server.proto.v1.SemesterRef
-	1,  // 33: go_amizone.server.proto.v1.AmizoneService.GetCurrentExamResult:input_type -> go_amizone.server.proto.v1.EmptyMessage
-	1,  // 34: go_amizone.server.proto.v1.AmizoneService.GetUserProfile:input_type -> go_amizone.server.proto.v1.EmptyMessage
-	1,  // 35: go_amizone.server.proto.v1.AmizoneService.GetWifiMacInfo:input_type -> go_amizone.server.proto.v1.EmptyMessage
-	26, // 36: go_amizone.server.proto.v1.AmizoneService.RegisterWifiMac:input_type -> go_amizone.server.proto.v1.RegisterWifiMacRequest
-	25, // 37: go_amizone.server.proto.v1.AmizoneService.DeregisterWifiMac:input_type -> go_amizone.server.proto.v1.DeregisterWifiMacRequest
-	27, // 38: go_amizone.server.proto.v1.AmizoneService.FillFacultyFeedback:input_type -> go_amizone.server.proto.v1.FillFacultyFeedbackRequest
-	15, // 39: go_amizone.server.proto.v1.AmizoneService.GetAttendance:output_type -> go_amizone.server.proto.v1.AttendanceRecords
-	17, // 40: go_amizone.server.proto.v1.AmizoneService.GetClassSchedule:output_type -> go_amizone.server.proto.v1.ScheduledClasses
-	20, // 41: go_amizone.server.proto.v1.AmizoneService.GetExamSchedule:output_type -> go_amizone.server.proto.v1.ExaminationSchedule
-	23, // 42: go_amizone.server.proto.v1.AmizoneService.GetSemesters:output_type -> go_amizone.server.proto.v1.SemesterList
-	13, // 43: go_amizone.server.proto.v1.AmizoneService.GetCourses:output_type -> go_amizone.server.proto.v1.Courses
-	13, // 44: go_amizone.server.proto.v1.AmizoneService.GetCurrentCourses:output_type -> go_amizone.server.proto.v1.Courses
-	11, // 45: go_amizone.server.proto.v1.AmizoneService.GetExamResult:output_type -> go_amizone.server.proto.v1.ExamResultRecords
-	11, // 46: go_amizone.server.proto.v1.AmizoneService.GetCurrentExamResult:output_type -> go_amizone.server.proto.v1.ExamResultRecords
-	21, // 47: go_amizone.server.proto.v1.AmizoneService.GetUserProfile:output_type -> go_amizone.server.proto.v1.Profile
-	24, // 48: go_amizone.server.proto.v1.AmizoneService.GetWifiMacInfo:output_type -> go_amizone.server.proto.v1.WifiMacInfo
-	1,  // 49: go_amizone.server.proto.v1.AmizoneService.RegisterWifiMac:output_type -> go_amizone.server.proto.v1.EmptyMessage
-	1,  // 50: go_amizone.server.proto.v1.AmizoneService.DeregisterWifiMac:output_type -> go_amizone.server.proto.v1.EmptyMessage
-	28, // 51: go_amizone.server.proto.v1.AmizoneService.FillFacultyFeedback:output_type -> go_amizone.server.proto.v1.FillFacultyFeedbackResponse
-	39, // [39:52] is the sub-list for method output_type
-	26, // [26:39] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	32, // 0: go_amizone.server.proto.v1.AuthToken.expires_at:type_name -> google.protobuf.Timestamp
+	33, // 1: go_amizone.server.proto.v1.ClassScheduleRequest.date:type_name -> google.type.Date
+	33, // 2: go_amizone.server.proto.v1.ClassScheduleRangeRequest.start:type_name -> google.type.Date
+	33, // 3: go_amizone.server.proto.v1.ClassScheduleRangeRequest.end:type_name -> google.type.Date
+	6,  // 4: go_amizone.server.proto.v1.ExamResultRecord.course:type_name -> go_amizone.server.proto.v1.CourseRef
+	11, // 5: go_amizone.server.proto.v1.ExamResultRecord.score:type_name -> go_amizone.server.proto.v1.Score
+	12, // 6: go_amizone.server.proto.v1.ExamResultRecord.credits:type_name -> go_amizone.server.proto.v1.Credits
+	33, // 7: go_amizone.server.proto.v1.ExamResultRecord.publish_date:type_name -> google.type.Date
+	7,  // 8: go_amizone.server.proto.v1.OverallResult.semester:type_name -> go_amizone.server.proto.v1.SemesterRef
+	10, // 9: go_amizone.server.proto.v1.ExamResultRecords.course_wise:type_name -> go_amizone.server.proto.v1.ExamResultRecord
+	13, // 10: go_amizone.server.proto.v1.ExamResultRecords.overall:type_name -> go_amizone.server.proto.v1.OverallResult
+	6,  // 11: go_amizone.server.proto.v1.Course.ref:type_name -> go_amizone.server.proto.v1.CourseRef
+	8,  // 12: go_amizone.server.proto.v1.Course.attendance:type_name -> go_amizone.server.proto.v1.Attendance
+	9,  // 13: go_amizone.server.proto.v1.Course.internal_marks:type_name -> go_amizone.server.proto.v1.Marks
+	15, // 14: go_amizone.server.proto.v1.Courses.courses:type_name -> go_amizone.server.proto.v1.Course
+	8,  // 15: go_amizone.server.proto.v1.AttendanceRecord.attendance:type_name -> go_amizone.server.proto.v1.Attendance
+	6,  // 16: go_amizone.server.proto.v1.AttendanceRecord.course:type_name -> go_amizone.server.proto.v1.CourseRef
+	17, // 17: go_amizone.server.proto.v1.AttendanceRecords.records:type_name -> go_amizone.server.proto.v1.AttendanceRecord
+	6,  // 18: go_amizone.server.proto.v1.ScheduledClass.course:type_name -> go_amizone.server.proto.v1.CourseRef
+	32, // 19: go_amizone.server.proto.v1.ScheduledClass.start_time:type_name -> google.protobuf.Timestamp
+	32, // 20: go_amizone.server.proto.v1.ScheduledClass.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 21: go_amizone.server.proto.v1.ScheduledClass.attendance:type_name -> go_amizone.server.proto.v1.AttendanceState
+	19, // 22: go_amizone.server.proto.v1.ScheduledClasses.classes:type_name -> go_amizone.server.proto.v1.ScheduledClass
+	6,  // 23: go_amizone.server.proto.v1.ScheduledExam.course:type_name -> go_amizone.server.proto.v1.CourseRef
+	32, // 24: go_amizone.server.proto.v1.ScheduledExam.time:type_name -> google.protobuf.Timestamp
+	22, // 25: go_amizone.server.proto.v1.ExaminationSchedule.exams:type_name -> go_amizone.server.proto.v1.ScheduledExam
+	32, // 26: go_amizone.server.proto.v1.Profile.enrollment_validity:type_name -> google.protobuf.Timestamp
+	32, // 27: go_amizone.server.proto.v1.Profile.date_of_birth:type_name -> google.protobuf.Timestamp
+	25, // 28: go_amizone.server.proto.v1.SemesterList.semesters:type_name -> go_amizone.server.proto.v1.Semester
+	2,  // 29: go_amizone.server.proto.v1.AuthService.Login:input_type -> go_amizone.server.proto.v1.LoginRequest
+	1,  // 30: go_amizone.server.proto.v1.AuthService.RefreshToken:input_type -> go_amizone.server.proto.v1.EmptyMessage
+	1,  // 31: go_amizone.server.proto.v1.AuthService.Logout:input_type -> go_amizone.server.proto.v1.EmptyMessage
+	1,  // 32: go_amizone.server.proto.v1.AmizoneService.GetAttendance:input_type -> go_amizone.server.proto.v1.EmptyMessage
+	4,  // 33: go_amizone.server.proto.v1.AmizoneService.GetClassSchedule:input_type -> go_amizone.server.proto.v1.ClassScheduleRequest
+	5,  // 34: go_amizone.server.proto.v1.AmizoneService.GetClassScheduleRange:input_type -> go_amizone.server.proto.v1.ClassScheduleRangeRequest
+	1,  // 35: go_amizone.server.proto.v1.AmizoneService.GetExamSchedule:input_type -> go_amizone.server.proto.v1.EmptyMessage
+	1,  // 36: go_amizone.server.proto.v1.AmizoneService.GetSemesters:input_type -> go_amizone.server.proto.v1.EmptyMessage
+	7,  // 37: go_amizone.server.proto.v1.AmizoneService.GetCourses:input_type -> go_amizone.server.proto.v1.SemesterRef
+	1,  // 38: go_amizone.server.proto.v1.AmizoneService.GetCurrentCourses:input_type -> go_amizone.server.proto.v1.EmptyMessage
+	7,  // 39: go_amizone.server.proto.v1.AmizoneService.GetExamResult:input_type -> go_amizone.server.proto.v1.SemesterRef
+	1,  // 40: go_amizone.server.proto.v1.AmizoneService.GetCurrentExamResult:input_type -> go_amizone.server.proto.v1.EmptyMessage
+	1,  // 41: go_amizone.server.proto.v1.AmizoneService.GetUserProfile:input_type -> go_amizone.server.proto.v1.EmptyMessage
+	1,  // 42: go_amizone.server.proto.v1.AmizoneService.GetWifiMacInfo:input_type -> go_amizone.server.proto.v1.EmptyMessage
+	29, // 43: go_amizone.server.proto.v1.AmizoneService.RegisterWifiMac:input_type -> go_amizone.server.proto.v1.RegisterWifiMacRequest
+	28, // 44: go_amizone.server.proto.v1.AmizoneService.DeregisterWifiMac:input_type -> go_amizone.server.proto.v1.DeregisterWifiMacRequest
+	30, // 45: go_amizone.server.proto.v1.AmizoneService.FillFacultyFeedback:input_type -> go_amizone.server.proto.v1.FillFacultyFeedbackRequest
+	3,  // 46: go_amizone.server.proto.v1.AuthService.Login:output_type -> go_amizone.server.proto.v1.AuthToken
+	3,  // 47: go_amizone.server.proto.v1.AuthService.RefreshToken:output_type -> go_amizone.server.proto.v1.AuthToken
+	1,  // 48: go_amizone.server.proto.v1.AuthService.Logout:output_type -> go_amizone.server.proto.v1.EmptyMessage
+	18, // 49: go_amizone.server.proto.v1.AmizoneService.GetAttendance:output_type -> go_amizone.server.proto.v1.AttendanceRecords
+	20, // 50: go_amizone.server.proto.v1.AmizoneService.GetClassSchedule:output_type -> go_amizone.server.proto.v1.ScheduledClasses
+	20, // 51: go_amizone.server.proto.v1.AmizoneService.GetClassScheduleRange:output_type -> go_amizone.server.proto.v1.ScheduledClasses
+	23, // 52: go_amizone.server.proto.v1.AmizoneService.GetExamSchedule:output_type -> go_amizone.server.proto.v1.ExaminationSchedule
+	26, // 53: go_amizone.server.proto.v1.AmizoneService.GetSemesters:output_type -> go_amizone.server.proto.v1.SemesterList
+	16, // 54: go_amizone.server.proto.v1.AmizoneService.GetCourses:output_type -> go_amizone.server.proto.v1.Courses
+	16, // 55: go_amizone.server.proto.v1.AmizoneService.GetCurrentCourses:output_type -> go_amizone.server.proto.v1.Courses
+	14, // 56: go_amizone.server.proto.v1.AmizoneService.GetExamResult:output_type -> go_amizone.server.proto.v1.ExamResultRecords
+	14, // 57: go_amizone.server.proto.v1.AmizoneService.GetCurrentExamResult:output_type -> go_amizone.server.proto.v1.ExamResultRecords
+	24, // 58: go_amizone.server.proto.v1.AmizoneService.GetUserProfile:output_type -> go_amizone.server.proto.v1.Profile
+	27, // 59: go_amizone.server.proto.v1.AmizoneService.GetWifiMacInfo:output_type -> go_amizone.server.proto.v1.WifiMacInfo
+	1,  // 60: go_amizone.server.proto.v1.AmizoneService.RegisterWifiMac:output_type -> go_amizone.server.proto.v1.EmptyMessage
+	1,  // 61: go_amizone.server.proto.v1.AmizoneService.DeregisterWifiMac:output_type -> go_amizone.server.proto.v1.EmptyMessage
+	31, // 62: go_amizone.server.proto.v1.AmizoneService.FillFacultyFeedback:output_type -> go_amizone.server.proto.v1.FillFacultyFeedbackResponse
+	46, // [46:63] is the sub-list for method output_type
+	29, // [29:46] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_v1_amizone_proto_init() }
@@ -2303,7 +2547,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClassScheduleRequest); i {
+			switch v := v.(*LoginRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2315,7 +2559,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CourseRef); i {
+			switch v := v.(*AuthToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2327,7 +2571,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SemesterRef); i {
+			switch v := v.(*ClassScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2339,7 +2583,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Attendance); i {
+			switch v := v.(*ClassScheduleRangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2351,7 +2595,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Marks); i {
+			switch v := v.(*CourseRef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2363,7 +2607,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExamResultRecord); i {
+			switch v := v.(*SemesterRef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2375,7 +2619,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Score); i {
+			switch v := v.(*Attendance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2387,7 +2631,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Credits); i {
+			switch v := v.(*Marks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2399,7 +2643,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OverallResult); i {
+			switch v := v.(*ExamResultRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2411,7 +2655,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExamResultRecords); i {
+			switch v := v.(*Score); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2423,7 +2667,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Course); i {
+			switch v := v.(*Credits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2435,7 +2679,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Courses); i {
+			switch v := v.(*OverallResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2447,7 +2691,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AttendanceRecord); i {
+			switch v := v.(*ExamResultRecords); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2459,7 +2703,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AttendanceRecords); i {
+			switch v := v.(*Course); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2471,7 +2715,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduledClass); i {
+			switch v := v.(*Courses); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2483,7 +2727,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduledClasses); i {
+			switch v := v.(*AttendanceRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2495,7 +2739,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AmizoneDiaryEvent); i {
+			switch v := v.(*AttendanceRecords); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2507,7 +2751,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduledExam); i {
+			switch v := v.(*ScheduledClass); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2519,7 +2763,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExaminationSchedule); i {
+			switch v := v.(*ScheduledClasses); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2531,7 +2775,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Profile); i {
+			switch v := v.(*AmizoneDiaryEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2543,7 +2787,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Semester); i {
+			switch v := v.(*ScheduledExam); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2555,7 +2799,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SemesterList); i {
+			switch v := v.(*ExaminationSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2567,7 +2811,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WifiMacInfo); i {
+			switch v := v.(*Profile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2579,7 +2823,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeregisterWifiMacRequest); i {
+			switch v := v.(*Semester); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2591,7 +2835,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterWifiMacRequest); i {
+			switch v := v.(*SemesterList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2603,7 +2847,7 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FillFacultyFeedbackRequest); i {
+			switch v := v.(*WifiMacInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2615,6 +2859,42 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 		file_v1_amizone_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterWifiMacRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_amizone_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterWifiMacRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_amizone_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillFacultyFeedbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_amizone_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FillFacultyFeedbackResponse); i {
 			case 0:
 				return &v.state
@@ -2627,16 +2907,16 @@ func file_v1_amizone_proto_init() {
 			}
 		}
 	}
-	file_v1_amizone_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_v1_amizone_proto_msgTypes[21].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_amizone_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   28,
+			NumMessages:   31,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_v1_amizone_proto_goTypes,
 		DependencyIndexes: file_v1_amizone_proto_depIdxs,
@@ -18,12 +18,179 @@ import (
 // Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthServiceClient interface {
+	// Login validates the credentials passed with Amizone and returns a bearer token for them.
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*AuthToken, error)
+	// RefreshToken returns a new bearer token in exchange for the one the call is authenticated with, which is revoked.
+	RefreshToken(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*AuthToken, error)
+	// Logout revokes the bearer token the call is authenticated with.
+	Logout(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*EmptyMessage, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*AuthToken, error) {
+	out := new(AuthToken)
+	err := c.cc.Invoke(ctx, "/go_amizone.server.proto.v1.AuthService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RefreshToken(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*AuthToken, error) {
+	out := new(AuthToken)
+	err := c.cc.Invoke(ctx, "/go_amizone.server.proto.v1.AuthService/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Logout(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, "/go_amizone.server.proto.v1.AuthService/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations must embed UnimplementedAuthServiceServer
+// for forward compatibility
+type AuthServiceServer interface {
+	// Login validates the credentials passed with Amizone and returns a bearer token for them.
+	Login(context.Context, *LoginRequest) (*AuthToken, error)
+	// RefreshToken returns a new bearer token in exchange for the one the call is authenticated with, which is revoked.
+	RefreshToken(context.Context, *EmptyMessage) (*AuthToken, error)
+	// Logout revokes the bearer token the call is authenticated with.
+	Logout(context.Context, *EmptyMessage) (*EmptyMessage, error)
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+// UnimplementedAuthServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAuthServiceServer struct {
+}
+
+func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*AuthToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *EmptyMessage) (*AuthToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAuthServiceServer) Logout(context.Context, *EmptyMessage) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_amizone.server.proto.v1.AuthService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_amizone.server.proto.v1.AuthService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RefreshToken(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_amizone.server.proto.v1.AuthService/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Logout(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go_amizone.server.proto.v1.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _AuthService_Login_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AuthService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AuthService_Logout_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/amizone.proto",
+}
+
 // AmizoneServiceClient is the client API for AmizoneService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AmizoneServiceClient interface {
 	GetAttendance(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*AttendanceRecords, error)
 	GetClassSchedule(ctx context.Context, in *ClassScheduleRequest, opts ...grpc.CallOption) (*ScheduledClasses, error)
+	// GetClassScheduleRange returns the classes scheduled on the days from start through end, inclusive, which
+	// Amizone serves in a single request. Ranges can be at most 62 days long.
+	GetClassScheduleRange(ctx context.Context, in *ClassScheduleRangeRequest, opts ...grpc.CallOption) (*ScheduledClasses, error)
 	// GetExamSchedule returns exam schedule. Amizone only allows access to schedules for the ongoing semester
 	// and only close to the exam dates, so we don't take any parameters.
 	GetExamSchedule(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*ExaminationSchedule, error)
@@ -84,6 +251,15 @@ func (c *amizoneServiceClient) GetClassSchedule(ctx context.Context, in *ClassSc
 	return out, nil
 }
 
+func (c *amizoneServiceClient) GetClassScheduleRange(ctx context.Context, in *ClassScheduleRangeRequest, opts ...grpc.CallOption) (*ScheduledClasses, error) {
+	out := new(ScheduledClasses)
+	err := c.cc.Invoke(ctx, "/go_amizone.server.proto.v1.AmizoneService/GetClassScheduleRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *amizoneServiceClient) GetExamSchedule(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*ExaminationSchedule, error) {
 	out := new(ExaminationSchedule)
 	err := c.cc.Invoke(ctx, "/go_amizone.server.proto.v1.AmizoneService/GetExamSchedule", in, out, opts...)
@@ -189,6 +365,9 @@ func (c *amizoneServiceClient) FillFacultyFeedback(ctx context.Context, in *Fill
 type AmizoneServiceServer interface {
 	GetAttendance(context.Context, *EmptyMessage) (*AttendanceRecords, error)
 	GetClassSchedule(context.Context, *ClassScheduleRequest) (*ScheduledClasses, error)
+	// GetClassScheduleRange returns the classes scheduled on the days from start through end, inclusive, which
+	// Amizone serves in a single request. Ranges can be at most 62 days long.
+	GetClassScheduleRange(context.Context, *ClassScheduleRangeRequest) (*ScheduledClasses, error)
 	// GetExamSchedule returns exam schedule. Amizone only allows access to schedules for the ongoing semester
 	// and only close to the exam dates, so we don't take any parameters.
 	GetExamSchedule(context.Context, *EmptyMessage) (*ExaminationSchedule, error)
@@ -234,6 +413,9 @@ func (UnimplementedAmizoneServiceServer) GetAttendance(context.Context, *EmptyMe
 func (UnimplementedAmizoneServiceServer) GetClassSchedule(context.Context, *ClassScheduleRequest) (*ScheduledClasses, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClassSchedule not implemented")
 }
+func (UnimplementedAmizoneServiceServer) GetClassScheduleRange(context.Context, *ClassScheduleRangeRequest) (*ScheduledClasses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassScheduleRange not implemented")
+}
 func (UnimplementedAmizoneServiceServer) GetExamSchedule(context.Context, *EmptyMessage) (*ExaminationSchedule, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExamSchedule not implemented")
 }
@@ -316,6 +498,24 @@ func _AmizoneService_GetClassSchedule_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AmizoneService_GetClassScheduleRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassScheduleRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmizoneServiceServer).GetClassScheduleRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_amizone.server.proto.v1.AmizoneService/GetClassScheduleRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmizoneServiceServer).GetClassScheduleRange(ctx, req.(*ClassScheduleRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AmizoneService_GetExamSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyMessage)
 	if err := dec(in); err != nil {
@@ -529,6 +729,10 @@ var AmizoneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetClassSchedule",
 			Handler:    _AmizoneService_GetClassSchedule_Handler,
 		},
+		{
+			MethodName: "GetClassScheduleRange",
+			Handler:    _AmizoneService_GetClassScheduleRange_Handler,
+		},
 		{
 			MethodName: "GetExamSchedule",
 			Handler:    _AmizoneService_GetExamSchedule_Handler,
@@ -24,35 +24,35 @@ func NewAmizoneServiceServer() v1.AmizoneServiceServer {
 }
 
 func (a *serviceServer) GetAttendance(ctx context.Context, _ *v1.EmptyMessage) (*v1.AttendanceRecords, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate")
 	}
 
-	attendance, err := amizoneClient.GetAttendance()
+	attendance, err := amizoneClient.GetAttendanceWithContext(ctx)
 	if err != nil {
-		return nil, errors.New("failed to retrieve attendance")
+		return nil, statusError(err, codes.Unknown, "failed to retrieve attendance")
 	}
 
 	return toproto.AttendanceRecords(attendance), nil
 }
 
 func (a *serviceServer) GetCurrentExamResult(ctx context.Context, _ *v1.EmptyMessage) (*v1.ExamResultRecords, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate")
 	}
 
-	examResult, err := amizoneClient.GetCurrentExaminationResult()
+	examResult, err := amizoneClient.GetCurrentExaminationResultWithContext(ctx)
 	if err != nil {
-		return nil, errors.New("failed to retrieve attendance")
+		return nil, statusError(err, codes.Unknown, "failed to retrieve attendance")
 	}
 
 	return toproto.ExaminationResultRecords(*examResult), nil
 }
 
 func (a *serviceServer) GetExamResult(ctx context.Context, in *v1.SemesterRef) (*v1.ExamResultRecords, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate")
 	}
@@ -61,16 +61,16 @@ func (a *serviceServer) GetExamResult(ctx context.Context, in *v1.SemesterRef) (
 		return nil, status.Errorf(codes.InvalidArgument, "semester ref is required")
 	}
 
-	examResult, err := amizoneClient.GetExaminationResult(in.GetSemesterRef())
+	examResult, err := amizoneClient.GetExaminationResultWithContext(ctx, in.GetSemesterRef())
 	if err != nil {
-		return nil, errors.New("failed to retrieve attendance")
+		return nil, statusError(err, codes.Unknown, "failed to retrieve attendance")
 	}
 
 	return toproto.ExaminationResultRecords(*examResult), nil
 }
 
 func (a serviceServer) GetClassSchedule(ctx context.Context, in *v1.ClassScheduleRequest) (*v1.ScheduledClasses, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate")
 	}
@@ -79,45 +79,66 @@ func (a serviceServer) GetClassSchedule(ctx context.Context, in *v1.ClassSchedul
 	if pDate == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "date is required")
 	}
-	nDate := fromproto.Date(pDate)
-	schedule, err := amizoneClient.GetClassSchedule(nDate.Date())
+	year, month, day := fromproto.Date(pDate).Date()
+	schedule, err := amizoneClient.GetClassScheduleWithContext(ctx, year, month, day)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve class schedule: %v", err)
+		return nil, statusError(err, codes.Internal, "failed to retrieve class schedule: %v", err)
+	}
+
+	return toproto.ScheduledClasses(schedule), nil
+}
+
+func (serviceServer) GetClassScheduleRange(ctx context.Context, in *v1.ClassScheduleRangeRequest) (*v1.ScheduledClasses, error) {
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate")
+	}
+
+	if in.GetStart() == nil || in.GetEnd() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start and end dates are required")
+	}
+	schedule, err := amizoneClient.GetClassScheduleRangeWithContext(ctx, fromproto.Date(in.GetStart()), fromproto.Date(in.GetEnd()))
+	if errors.Is(err, amizone.ErrInvalidDateRange) {
+		return nil, statusError(err, codes.InvalidArgument, "the range must end after it starts, and be at most %d days long",
+			amizone.MaxClassScheduleRangeDays)
+	}
+	if err != nil {
+		return nil, statusError(err, codes.Internal, "failed to retrieve class schedule: %v", err)
 	}
 
 	return toproto.ScheduledClasses(schedule), nil
 }
 
 func (serviceServer) GetExamSchedule(ctx context.Context, _ *v1.EmptyMessage) (*v1.ExaminationSchedule, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate")
 	}
 
-	schedule, err := amizoneClient.GetExamSchedule()
+	schedule, err := amizoneClient.GetExamScheduleWithContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve exam schedule: %v", err)
+		return nil, statusError(err, codes.Internal, "failed to retrieve exam schedule: %v", err)
 	}
 
 	return toproto.ExamSchedule(*schedule), nil
 }
 
 func (serviceServer) GetSemesters(ctx context.Context, _ *v1.EmptyMessage) (*v1.SemesterList, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate")
 	}
 
-	semesters, err := amizoneClient.GetSemesters()
+	semesters, err := amizoneClient.GetSemestersWithContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve semesters: %v", err)
+		return nil, statusError(err, codes.Internal, "failed to retrieve semesters: %v", err)
 	}
 
 	return toproto.SemesterList(semesters), nil
 }
 
 func (serviceServer) GetCourses(ctx context.Context, in *v1.SemesterRef) (*v1.Courses, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate")
 	}
@@ -126,57 +147,57 @@ func (serviceServer) GetCourses(ctx context.Context, in *v1.SemesterRef) (*v1.Co
 		return nil, status.Errorf(codes.InvalidArgument, "semester ref is required")
 	}
 
-	courses, err := amizoneClient.GetCourses(in.GetSemesterRef())
+	courses, err := amizoneClient.GetCoursesWithContext(ctx, in.GetSemesterRef())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve courses: %v", err)
+		return nil, statusError(err, codes.Internal, "failed to retrieve courses: %v", err)
 	}
 
 	return toproto.Courses(courses), nil
 }
 
 func (serviceServer) GetCurrentCourses(ctx context.Context, _ *v1.EmptyMessage) (*v1.Courses, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to authenticate")
 	}
 
-	courses, err := amizoneClient.GetCurrentCourses()
+	courses, err := amizoneClient.GetCurrentCoursesWithContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve courses: %v", err)
+		return nil, statusError(err, codes.Internal, "failed to retrieve courses: %v", err)
 	}
 
 	return toproto.Courses(courses), nil
 }
 
 func (serviceServer) GetUserProfile(ctx context.Context, _ *v1.EmptyMessage) (*v1.Profile, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "failed to authenticate")
 	}
 
-	profile, err := amizoneClient.GetUserProfile()
+	profile, err := amizoneClient.GetUserProfileWithContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve user-profile: %v", err)
+		return nil, statusError(err, codes.Internal, "failed to retrieve user-profile: %v", err)
 	}
 	return toproto.Profile(*profile), nil
 }
 
 func (serviceServer) GetWifiMacInfo(ctx context.Context, _ *v1.EmptyMessage) (*v1.WifiMacInfo, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "failed to authenticate")
 	}
 
-	macInfo, err := amizoneClient.GetWiFiMacInformation()
+	macInfo, err := amizoneClient.GetWiFiMacInformationWithContext(ctx)
 	if err != nil {
 		// TODO: ! reevalute these error codes, I get the feeling they shouldn't just be codes.Internal
-		return nil, status.Errorf(codes.Internal, "failed to retrieve mac info")
+		return nil, statusError(err, codes.Internal, "failed to retrieve mac info")
 	}
 	return toproto.WifiInfo(*macInfo), nil
 }
 
 func (serviceServer) RegisterWifiMac(ctx context.Context, req *v1.RegisterWifiMacRequest) (*v1.EmptyMessage, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "failed to authenticate")
 	}
@@ -185,16 +206,16 @@ func (serviceServer) RegisterWifiMac(ctx context.Context, req *v1.RegisterWifiMa
 		return nil, status.Errorf(codes.InvalidArgument, "bad mac address")
 	}
 
-	err = amizoneClient.RegisterWifiMac(addr, req.OverrideLimit)
+	err = amizoneClient.RegisterWifiMacWithContext(ctx, addr, req.OverrideLimit)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed to register: %s", err.Error())
+		return nil, statusError(err, codes.Unknown, "failed to register: %s", err.Error())
 	}
 
 	return &v1.EmptyMessage{}, nil
 }
 
 func (serviceServer) DeregisterWifiMac(ctx context.Context, req *v1.DeregisterWifiMacRequest) (*v1.EmptyMessage, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "failed to authenticate")
 	}
@@ -203,23 +224,23 @@ func (serviceServer) DeregisterWifiMac(ctx context.Context, req *v1.DeregisterWi
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "bad mac address")
 	}
-	err = amizoneClient.RemoveWifiMac(addr)
+	err = amizoneClient.RemoveWifiMacWithContext(ctx, addr)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed removal: %s", err.Error())
+		return nil, statusError(err, codes.Unknown, "failed removal: %s", err.Error())
 	}
 
 	return &v1.EmptyMessage{}, nil
 }
 
 func (serviceServer) FillFacultyFeedback(ctx context.Context, req *v1.FillFacultyFeedbackRequest) (*v1.FillFacultyFeedbackResponse, error) {
-	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(*amizone.Client)
+	amizoneClient, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "failed to authenticate")
 	}
 
-	filledFor, err := amizoneClient.SubmitFacultyFeedbackHack(req.Rating, req.QueryRating, req.Comment)
+	filledFor, err := amizoneClient.SubmitFacultyFeedbackHackWithContext(ctx, req.Rating, req.QueryRating, req.Comment)
 	if err != nil {
-		return nil, status.Errorf(codes.Unknown, "failed submission: %s", err.Error())
+		return nil, statusError(err, codes.Unknown, "failed submission: %s", err.Error())
 	}
 
 	return &v1.FillFacultyFeedbackResponse{FilledFor: filledFor}, nil
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/amizonetest"
+	"github.com/ditsuke/go-amizone/amizone/models"
+	v1 "github.com/ditsuke/go-amizone/server/gen/go/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceServer_ClientFactory(t *testing.T) {
+	newServer := func(factory amizone.ClientFactoryInterface) *ApiServer {
+		config := NewConfig("")
+		config.Logger = logr.Discard()
+		config.ClientFactory = factory
+		return New(config)
+	}
+	basicAuthCtx := func() context.Context {
+		auth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic "+auth))
+	}
+
+	t.Run("handlers use clients from the factory", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &amizonetest.MockClient{
+			GetSemestersFunc: func(context.Context) (models.SemesterList, error) {
+				return models.SemesterList{{Name: "Semester 1", Ref: "1"}}, nil
+			},
+		}
+		var factoryCred amizone.Credentials
+		factory := func(ctx context.Context, cred amizone.Credentials, _ *http.Client, opts ...amizone.ClientOption) (amizone.ClientInterface, error) {
+			factoryCred = cred
+			return amizonetest.ClientFactory(client)(ctx, cred, nil, opts...)
+		}
+
+		ctx, err := newServer(factory).authorizeCtx(basicAuthCtx())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(factoryCred).To(Equal(amizone.Credentials{Username: "user", Password: "pass"}))
+
+		semesters, err := NewAmizoneServiceServer().GetSemesters(ctx, &v1.EmptyMessage{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(semesters.GetSemesters()).To(HaveLen(1))
+		g.Expect(semesters.GetSemesters()[0].GetRef()).To(Equal("1"))
+		g.Expect(client.CallCount("GetSemesters")).To(Equal(1))
+	})
+
+	t.Run("client errors are reported", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &amizonetest.MockClient{}
+
+		ctx, err := newServer(amizonetest.ClientFactory(client)).authorizeCtx(basicAuthCtx())
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = NewAmizoneServiceServer().GetAttendance(ctx, &v1.EmptyMessage{})
+		g.Expect(status.Code(err)).To(Equal(codes.Unknown))
+		g.Expect(err).To(MatchError(amizonetest.ErrUnexpectedCall))
+	})
+
+	t.Run("factory errors fail authentication", func(t *testing.T) {
+		g := NewWithT(t)
+		factory := func(context.Context, amizone.Credentials, *http.Client, ...amizone.ClientOption) (amizone.ClientInterface, error) {
+			return nil, amizone.ErrFailedLogin
+		}
+
+		_, err := newServer(factory).authorizeCtx(basicAuthCtx())
+		g.Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+	})
+}
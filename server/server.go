@@ -2,13 +2,18 @@ package server
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"net"
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/sessionstore"
 	v1 "github.com/ditsuke/go-amizone/server/gen/go/v1"
 	"github.com/go-logr/logr"
 	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
@@ -24,21 +29,86 @@ import (
 
 type ContextKey string
 
-const ContextAmizoneClientKey ContextKey = "amizone_client"
+const (
+	ContextAmizoneClientKey ContextKey = "amizone_client"
+	contextSessionKey       ContextKey = "amizone_session"
+	contextTokenKey         ContextKey = "auth_token"
+)
+
+// Defaults for the limits applied to requests made to Amizone by the server.
+const (
+	DefaultRateLimit   = 10.0
+	DefaultRateBurst   = 20
+	DefaultMaxInFlight = 16
+)
 
 // Config is the configuration entity for ApiServer.
 type Config struct {
 	Logger       logr.Logger
 	BindAddr     string
 	WellKnownDir string
+	// AmizoneBaseURL is the base URL of the Amizone deployment the server talks to. Defaults to amizone.BaseURL;
+	// it can be pointed to a fake portal, like the one served by cmd/amizone-fake-portal, for development.
+	AmizoneBaseURL string
+	// SessionStore caches Amizone sessions across calls, so that the server doesn't have to log in to
+	// Amizone on every call. A nil SessionStore disables caching.
+	SessionStore sessionstore.Store
+	// TokenKey is the secret bearer tokens are signed and encrypted with. It should be at least 32 random bytes.
+	// If empty, a random key is generated on Init, so tokens are invalidated when the server restarts.
+	// Revocations of tokens are persisted through SessionStore: with a key that outlives the server, revoked
+	// tokens stay revoked across restarts only if the SessionStore does too.
+	TokenKey []byte
+	// TokenTTL is the lifetime of bearer tokens. Defaults to DefaultTokenTTL.
+	TokenTTL time.Duration
+	// MaxSessionAge bounds how long bearer tokens can be refreshed for after logging in, after which users must
+	// log in again. Defaults to DefaultMaxSessionAge.
+	MaxSessionAge time.Duration
+	// CalendarFeedTTL is the lifetime of the calendar feed URLs created at CalendarFeedsPath. Feed tokens are
+	// derived from TokenKey too, and calendar feeds are only served if TokenKey is set. Feeds are revoked through
+	// SessionStore like tokens, one by one or all of a user's on Logout. Defaults to DefaultCalendarFeedTTL.
+	CalendarFeedTTL time.Duration
+	// PublicURL is the URL clients reach the server at, like "https://amizone.example.com", which calendar feed
+	// URLs are built from. If empty, they're built from the Host header of the request creating the feed, which
+	// clients control, so it should be set in production.
+	PublicURL string
+	// TrustProxyHeaders makes the server build calendar feed URLs from the X-Forwarded-Proto and X-Forwarded-Host
+	// headers of requests when PublicURL is empty. Set it only behind a reverse proxy that sets these headers.
+	TrustProxyHeaders bool
+	// RetryPolicy configures how requests to Amizone are retried when they fail transiently.
+	RetryPolicy amizone.RetryPolicy
+	// CircuitBreaker is shared by all clients the server creates, so that calls fail fast with
+	// codes.Unavailable while Amizone is down. A nil CircuitBreaker disables circuit breaking.
+	CircuitBreaker *amizone.CircuitBreaker
+	// ClientFactory creates the clients used to serve calls. Defaults to amizone.DefaultClientFactory; tests can
+	// inject mocks, like those of the amizonetest package.
+	ClientFactory amizone.ClientFactoryInterface
+	// Limiter is shared by all clients the server creates, bounding the requests the server makes to Amizone
+	// across all users, so that its IP address doesn't get blocked. A nil Limiter disables the limits.
+	Limiter *amizone.Limiter
+	// HealthMonitor is shared by all clients the server creates, checking a sample of the pages they fetch against
+	// what the parsers expect of them. Its report is served to admins at AdminParserHealthPath. A nil HealthMonitor
+	// disables the checks.
+	HealthMonitor *amizone.HealthMonitor
+	// AdminToken is the bearer token admin endpoints are authenticated with. If empty, admin endpoints aren't
+	// served.
+	AdminToken string
 }
 
 // NewConfig returns a Config with sensible defaults and a logr.Discard logger.
 func NewConfig(bindAddress string) *Config {
 	return &Config{
-		BindAddr:     bindAddress,
-		Logger:       logr.Discard(),
-		WellKnownDir: "",
+		BindAddr:        bindAddress,
+		Logger:          logr.Discard(),
+		WellKnownDir:    "",
+		SessionStore:    sessionstore.NewMemoryStore(sessionstore.DefaultCacheSize, sessionstore.DefaultTTL),
+		ClientFactory:   amizone.DefaultClientFactory,
+		TokenTTL:        DefaultTokenTTL,
+		MaxSessionAge:   DefaultMaxSessionAge,
+		CalendarFeedTTL: DefaultCalendarFeedTTL,
+		RetryPolicy:     amizone.DefaultRetryPolicy,
+		CircuitBreaker:  amizone.NewCircuitBreaker(amizone.DefaultCircuitFailureThreshold, amizone.DefaultCircuitCooldown),
+		Limiter:         amizone.NewLimiter(DefaultRateLimit, DefaultRateBurst, DefaultMaxInFlight),
+		HealthMonitor:   amizone.NewHealthMonitor(amizone.DefaultHealthSampleRate),
 	}
 }
 
@@ -51,6 +121,12 @@ type ApiServer struct {
 	}
 	config     *Config
 	httpServer *http.Server
+	// gatewayConn is the connection grpc-gateway forwards requests to the gRPC server over.
+	gatewayConn *grpc.ClientConn
+	tokens      *tokenAuthority
+	feeds       *feedAuthority
+	// sessionKeySecret keys the HMACs sessionKey derives SessionStore keys with.
+	sessionKeySecret []byte
 }
 
 func New(config *Config) *ApiServer {
@@ -67,6 +143,30 @@ func (s *ApiServer) Init() {
 		return
 	}
 	s.config.Logger.V(1).Info("Configuring server and router...")
+	tokenKey := s.config.TokenKey
+	if len(tokenKey) == 0 {
+		s.config.Logger.Info("No token key configured, generating one: bearer tokens and cached sessions won't survive restarts, and calendar feeds aren't served")
+		tokenKey = make([]byte, 32)
+		if _, err := rand.Read(tokenKey); err != nil {
+			panic("failed to generate token key: " + err.Error())
+		}
+	}
+	tokens, err := newTokenAuthority(tokenKey, s.config.TokenTTL, s.config.SessionStore)
+	if err != nil {
+		panic("failed to set up token authority: " + err.Error())
+	}
+	s.tokens = tokens
+	mac := hmac.New(sha256.New, tokenKey)
+	mac.Write([]byte("session keys"))
+	s.sessionKeySecret = mac.Sum(nil)
+	// Calendar apps are subscribed to feeds for months, so feeds are only served with a key that outlives the server.
+	if len(s.config.TokenKey) != 0 {
+		feeds, err := newFeedAuthority(tokenKey, s.config.CalendarFeedTTL, s.config.SessionStore)
+		if err != nil {
+			panic("failed to set up calendar feed token authority: " + err.Error())
+		}
+		s.feeds = feeds
+	}
 	s.router = h2c.NewHandler(s.newRouter(), &http2.Server{})
 	s.httpServer = &http.Server{
 		Addr:    s.config.BindAddr,
@@ -96,7 +196,11 @@ func (s *ApiServer) ListenAndServe() error {
 
 // Stop stops the server.
 func (s *ApiServer) Stop(ctx context.Context) error {
-	return s.httpServer.Shutdown(ctx)
+	err := s.httpServer.Shutdown(ctx)
+	if s.gatewayConn != nil {
+		_ = s.gatewayConn.Close()
+	}
+	return err
 }
 
 // newRouter creates a new router for the ApiServer that routes gRPC and HTTP requests to
@@ -115,8 +219,13 @@ func (s *ApiServer) newRouter() http.Handler {
 }
 
 func (s *ApiServer) newGrpcServer() *grpc.Server {
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(grpcAuth.UnaryServerInterceptor(authorizeCtx)))
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		amizoneErrorInterceptor,
+		grpcAuth.UnaryServerInterceptor(s.authorizeCtx),
+		s.sessionInterceptor,
+	))
 	v1.RegisterAmizoneServiceServer(grpcServer, NewAmizoneServiceServer())
+	v1.RegisterAuthServiceServer(grpcServer, &authServiceServer{server: s})
 	reflection.Register(grpcServer)
 	return grpcServer
 }
@@ -146,9 +255,27 @@ func (s *ApiServer) newHttpMux() *http.ServeMux {
 		// @todo check if caller accommodates for the nil return
 		return nil
 	}
-	err = v1.RegisterAmizoneServiceHandlerFromEndpoint(context.Background(), gwMux, "localhost:"+port, []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())})
+	// Both services share a connection, so that the gateway gets through to both once it gets through to one.
+	conn, err := grpc.Dial("localhost:"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		s.config.Logger.Error(err, "Failed to register grpc-gateway")
+		s.config.Logger.Error(err, "Failed to dial the gRPC server for grpc-gateway")
+	} else {
+		s.gatewayConn = conn
+		if err := v1.RegisterAmizoneServiceHandler(context.Background(), gwMux, conn); err != nil {
+			s.config.Logger.Error(err, "Failed to register grpc-gateway")
+		}
+		if err := v1.RegisterAuthServiceHandler(context.Background(), gwMux, conn); err != nil {
+			s.config.Logger.Error(err, "Failed to register grpc-gateway for the auth service")
+		}
+	}
+	if s.config.AdminToken != "" {
+		mux.Handle(AdminParserHealthPath, s.requireAdmin(http.HandlerFunc(s.serveParserHealth)))
+	} else {
+		s.config.Logger.Info("No admin token configured, not serving admin endpoints")
+	}
+	if s.feeds != nil {
+		mux.HandleFunc(CalendarFeedsPath, s.serveCalendarFeeds)
+		mux.HandleFunc(CalendarFeedsPath+"/", s.serveCalendarFeed)
 	}
 	mux.HandleFunc("/api/", func(rw http.ResponseWriter, req *http.Request) {
 		gwMux.ServeHTTP(rw, req)
@@ -164,27 +291,63 @@ func isGrpc(r *http.Request) bool {
 	return false
 }
 
-// authorizeCtx is a grpc_auth.AuthFunc. It authorizes the request by checking for
-// the (currently) supported Basic auth header and then validating the credentials by
-// getting a logged-in instance of amizone.Client.
-func authorizeCtx(ctx context.Context) (context.Context, error) {
-	credentialsEncoded, err := grpcAuth.AuthFromMD(ctx, "basic")
+// authorizeCtx is a grpc_auth.AuthFunc. It authorizes the request by checking for a Bearer token issued
+// by AuthService or for Basic auth credentials, and then validating the credentials by getting a logged-in
+// instance of amizone.Client, resuming a cached session where possible.
+func (s *ApiServer) authorizeCtx(ctx context.Context) (context.Context, error) {
+	var cred amizone.Credentials
+	if _, err := grpcAuth.AuthFromMD(ctx, "bearer"); err == nil {
+		ctx, err = s.authorizeToken(ctx)
+		if err != nil {
+			return ctx, err
+		}
+		cred = ctx.Value(contextTokenKey).(*tokenInfo).credentials
+	} else {
+		cred, err = basicCredentials(ctx)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	client, cached, err := s.newAmizoneClient(ctx, cred)
+	if err != nil {
+		return ctx, statusError(err, codes.Unauthenticated, "amizone: %s", err.Error())
+	}
+	ctx = context.WithValue(ctx, ContextAmizoneClientKey, client)
+	if cached != nil {
+		ctx = context.WithValue(ctx, contextSessionKey, cached)
+	}
+	return ctx, nil
+}
+
+// authorizeToken authorizes the request by verifying its Bearer token, which is then made available through
+// the contextTokenKey context value.
+func (s *ApiServer) authorizeToken(ctx context.Context) (context.Context, error) {
+	token, err := grpcAuth.AuthFromMD(ctx, "bearer")
 	if err != nil {
 		return ctx, err
 	}
+	claims, cred, err := s.tokens.verify(token)
+	if err != nil {
+		return ctx, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return context.WithValue(ctx, contextTokenKey, &tokenInfo{claims: claims, credentials: cred}), nil
+}
+
+// basicCredentials extracts credentials from the Basic auth header of the request.
+func basicCredentials(ctx context.Context) (amizone.Credentials, error) {
+	credentialsEncoded, err := grpcAuth.AuthFromMD(ctx, "basic")
+	if err != nil {
+		return amizone.Credentials{}, err
+	}
 	// Base 64 decode
 	credentials, err := base64.StdEncoding.DecodeString(credentialsEncoded)
 	if err != nil {
-		return ctx, err
+		return amizone.Credentials{}, err
 	}
 	index := strings.IndexByte(string(credentials), ':')
 	if index == -1 || index == 0 || index == len(credentials)-1 {
-		return ctx, status.Errorf(codes.Unauthenticated, "bad auth string")
-	}
-	user, pass := string(credentials[:index]), string(credentials[index+1:])
-	client, err := amizone.NewClient(amizone.Credentials{Username: user, Password: pass}, nil)
-	if err != nil {
-		return ctx, status.Error(codes.Unauthenticated, "amizone: "+err.Error())
+		return amizone.Credentials{}, status.Errorf(codes.Unauthenticated, "bad auth string")
 	}
-	return context.WithValue(ctx, ContextAmizoneClientKey, client), nil
+	return amizone.Credentials{Username: string(credentials[:index]), Password: string(credentials[index+1:])}, nil
 }
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/sessionstore"
+	"google.golang.org/grpc"
+)
+
+// cachedSession tracks the session an amizone.Client was created with for the duration of a call, so that
+// the session can be updated or evicted once the call completes.
+type cachedSession struct {
+	key string
+	// session is the session the client was resumed from, or nil if the client had to log in.
+	session []byte
+}
+
+// sessionKey derives the SessionStore key for cred. Keys are HMACs of the credentials under a key derived from
+// the token key, so stores never see the credentials, nor can they brute-force them from keys.
+func (s *ApiServer) sessionKey(cred amizone.Credentials) string {
+	mac := hmac.New(sha256.New, s.sessionKeySecret)
+	mac.Write([]byte(cred.Username + "\x00" + cred.Password))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// sessionExporter is implemented by clients whose sessions can be cached, like amizone.Client.
+type sessionExporter interface {
+	ExportSession() ([]byte, error)
+}
+
+// newAmizoneClient returns a client for cred created with the configured ClientFactory, resuming the session
+// cached for the credentials if there's one, and logging in otherwise. The *cachedSession returned is nil when
+// session caching is disabled.
+func (s *ApiServer) newAmizoneClient(ctx context.Context, cred amizone.Credentials) (amizone.ClientInterface, *cachedSession, error) {
+	factory := s.config.ClientFactory
+	if factory == nil {
+		factory = amizone.DefaultClientFactory
+	}
+	opts := s.clientOptions()
+	store := s.config.SessionStore
+	if store == nil {
+		client, err := factory(ctx, cred, nil, opts...)
+		return client, nil, err
+	}
+
+	cached := &cachedSession{key: s.sessionKey(cred)}
+	session, err := store.Get(cached.key)
+	switch {
+	case err == nil:
+		client, err := factory(ctx, cred, nil, append(opts, amizone.WithSession(session))...)
+		if err == nil {
+			cached.session = session
+			return client, cached, nil
+		}
+		s.config.Logger.V(1).Info("Evicting unusable cached session", "error", err.Error())
+		s.evictSession(cached.key)
+	case !errors.Is(err, sessionstore.ErrNotFound):
+		s.config.Logger.Error(err, "Failed to retrieve cached session")
+	}
+
+	client, err := factory(ctx, cred, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, cached, nil
+}
+
+// loginAmizoneClient returns a client for cred like newAmizoneClient, but always logs in rather than resuming a
+// cached session, for calls that must check the credentials against Amizone. The session it logs in with
+// replaces the cached one once synced with syncSession.
+func (s *ApiServer) loginAmizoneClient(ctx context.Context, cred amizone.Credentials) (amizone.ClientInterface, *cachedSession, error) {
+	factory := s.config.ClientFactory
+	if factory == nil {
+		factory = amizone.DefaultClientFactory
+	}
+	client, err := factory(ctx, cred, nil, s.clientOptions()...)
+	if s.config.SessionStore == nil {
+		return client, nil, err
+	}
+	key := s.sessionKey(cred)
+	if errors.Is(err, amizone.ErrFailedLogin) {
+		// The credentials no longer log in, so the session cached for them mustn't be resumed either.
+		s.evictSession(key)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, &cachedSession{key: key}, nil
+}
+
+// clientOptions returns the options for the amizone.Client instances created by the server.
+func (s *ApiServer) clientOptions() []amizone.ClientOption {
+	opts := []amizone.ClientOption{amizone.WithRetryPolicy(s.config.RetryPolicy)}
+	if s.config.AmizoneBaseURL != "" {
+		opts = append(opts, amizone.WithBaseURL(s.config.AmizoneBaseURL))
+	}
+	if s.config.CircuitBreaker != nil {
+		opts = append(opts, amizone.WithCircuitBreaker(s.config.CircuitBreaker))
+	}
+	if s.config.Limiter != nil {
+		opts = append(opts, amizone.WithLimiter(s.config.Limiter))
+	}
+	if s.config.HealthMonitor != nil {
+		opts = append(opts, amizone.WithHealthMonitor(s.config.HealthMonitor))
+	}
+	return opts
+}
+
+// sessionInterceptor is a grpc.UnaryServerInterceptor that keeps the session cache up to date after a call
+// completes, through syncSession.
+func (s *ApiServer) sessionInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+
+	cached, ok := ctx.Value(contextSessionKey).(*cachedSession)
+	if !ok {
+		return resp, err
+	}
+	client, ok := ctx.Value(ContextAmizoneClientKey).(amizone.ClientInterface)
+	if !ok {
+		return resp, err
+	}
+	s.syncSession(client, cached, err)
+	return resp, err
+}
+
+// syncSession updates the session cache with the session of client, a client created by newAmizoneClient,
+// after it's been used. callErr is the error of the call the client was used for, if any.
+// Sessions Amizone reports as logged out are evicted, and new or refreshed sessions are stored. Clients that
+// can't export their session are left alone.
+func (s *ApiServer) syncSession(client amizone.ClientInterface, cached *cachedSession, callErr error) {
+	exporter, ok := client.(sessionExporter)
+	if !ok {
+		return
+	}
+
+	// The client logs in again when Amizone reports its session as logged out, so either error means that
+	// the session is unusable and that we couldn't get a new one.
+	if errors.Is(callErr, amizone.ErrNotLoggedIn) || errors.Is(callErr, amizone.ErrFailedLogin) {
+		s.evictSession(cached.key)
+		return
+	}
+
+	session, err := exporter.ExportSession()
+	if err != nil {
+		s.evictSession(cached.key)
+		return
+	}
+	// The session only changes when the client had to log in, which is when it needs storing.
+	if !bytes.Equal(session, cached.session) {
+		if err := s.config.SessionStore.Set(cached.key, session); err != nil {
+			s.config.Logger.Error(err, "Failed to cache session")
+		}
+	}
+}
+
+// evictSession removes the session cached for key, logging failures.
+func (s *ApiServer) evictSession(key string) {
+	if err := s.config.SessionStore.Delete(key); err != nil {
+		s.config.Logger.Error(err, "Failed to evict cached session")
+	}
+}
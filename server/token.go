@@ -0,0 +1,224 @@
+package server
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/sessionstore"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultTokenTTL is the default lifetime of bearer tokens issued by the server.
+const DefaultTokenTTL = 24 * time.Hour
+
+const tokenIssuer = "go-amizone"
+
+var (
+	ErrInvalidToken = errors.New("invalid or expired token")
+	ErrRevokedToken = fmt.Errorf("%w: token revoked", ErrInvalidToken)
+)
+
+// tokenClaims are the claims of bearer tokens issued by the server. Besides the registered claims, tokens carry
+// the user's Amizone credentials, encrypted, so that the server can log in on the user's behalf whenever it has
+// no usable session for them without having to store credentials itself.
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Credentials string `json:"crd"`
+	// AuthTime is when the user logged in with their credentials. It's carried over to the tokens a token is
+	// refreshed for, so that sessions can't be refreshed forever.
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
+	// Generation is the feed generation of the user a calendar feed token was issued in. See feedAuthority.
+	Generation uint64 `json:"gen,omitempty"`
+}
+
+// authTime returns when the user the token with claims was issued for logged in.
+func (c *tokenClaims) authTime() time.Time {
+	if c.AuthTime != nil {
+		return c.AuthTime.Time
+	}
+	return c.IssuedAt.Time
+}
+
+// revocationKeyPrefix prefixes the SessionStore keys token revocations are persisted under, keeping them apart
+// from the session keys derived by sessionKey.
+const revocationKeyPrefix = "revoked-token:"
+
+// tokenAuthority issues, verifies and revokes bearer tokens. Tokens are JWTs signed with HMAC-SHA256. The
+// credentials they carry are encrypted with AES-GCM, bound to the token ID.
+type tokenAuthority struct {
+	signingKey []byte
+	aead       cipher.AEAD
+	ttl        time.Duration
+	// store persists revocations, so that revoked tokens stay revoked across restarts when the store does. A nil
+	// store keeps revocations in memory only.
+	store sessionstore.Store
+
+	mu sync.Mutex
+	// revoked maps the IDs of revoked tokens to their expiry, after which they can be forgotten.
+	revoked map[string]time.Time
+}
+
+// newTokenAuthority returns a tokenAuthority issuing tokens valid for ttl and persisting revocations to store,
+// which may be nil. Signing and encryption keys are derived from key, which should be at least 32 random bytes.
+func newTokenAuthority(key []byte, ttl time.Duration, store sessionstore.Store) (*tokenAuthority, error) {
+	if len(key) == 0 {
+		return nil, errors.New("token key must not be empty")
+	}
+	if ttl <= 0 {
+		ttl = DefaultTokenTTL
+	}
+	deriveKey := func(purpose string) []byte {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(purpose))
+		return mac.Sum(nil)
+	}
+
+	block, err := aes.NewCipher(deriveKey("encryption"))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenAuthority{
+		signingKey: deriveKey("signing"),
+		aead:       aead,
+		ttl:        ttl,
+		store:      store,
+		revoked:    make(map[string]time.Time),
+	}, nil
+}
+
+// issue returns a new token for cred, who just logged in, and the time it expires at.
+func (t *tokenAuthority) issue(cred amizone.Credentials) (string, time.Time, error) {
+	return t.sign(cred, time.Now(), 0)
+}
+
+// issueGeneration returns a new token for cred like issue, carrying generation in its claims.
+func (t *tokenAuthority) issueGeneration(cred amizone.Credentials, generation uint64) (string, time.Time, error) {
+	return t.sign(cred, time.Now(), generation)
+}
+
+// reissue returns a new token for cred in place of the token with claims, for the same login.
+func (t *tokenAuthority) reissue(claims *tokenClaims, cred amizone.Credentials) (string, time.Time, error) {
+	return t.sign(cred, claims.authTime(), claims.Generation)
+}
+
+// sign returns a new token for cred, who logged in at authTime, and the time it expires at.
+func (t *tokenAuthority) sign(cred amizone.Credentials, authTime time.Time, generation uint64) (string, time.Time, error) {
+	id := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, id); err != nil {
+		return "", time.Time{}, err
+	}
+	nonce := make([]byte, t.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", time.Time{}, err
+	}
+
+	now := time.Now()
+	expiry := now.Add(t.ttl)
+	claims := tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        hex.EncodeToString(id),
+			Issuer:    tokenIssuer,
+			Subject:   cred.Username,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiry),
+		},
+		AuthTime:   jwt.NewNumericDate(authTime),
+		Generation: generation,
+	}
+	encrypted := t.aead.Seal(nonce, nonce, []byte(cred.Username+"\x00"+cred.Password), []byte(claims.ID))
+	claims.Credentials = base64.RawURLEncoding.EncodeToString(encrypted)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(t.signingKey)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	// NumericDate has a granularity of seconds, so that's what the token expires at.
+	return token, claims.ExpiresAt.Time, nil
+}
+
+// verify checks that token was issued by t and is neither expired nor revoked, and returns its claims along
+// with the credentials it carries.
+func (t *tokenAuthority) verify(token string) (*tokenClaims, amizone.Credentials, error) {
+	var claims tokenClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return t.signingKey, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(tokenIssuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, amizone.Credentials{}, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+	if t.isRevoked(claims.ID) {
+		return nil, amizone.Credentials{}, ErrRevokedToken
+	}
+
+	encrypted, err := base64.RawURLEncoding.DecodeString(claims.Credentials)
+	if err != nil || len(encrypted) < t.aead.NonceSize() {
+		return nil, amizone.Credentials{}, ErrInvalidToken
+	}
+	nonceSize := t.aead.NonceSize()
+	plaintext, err := t.aead.Open(nil, encrypted[:nonceSize], encrypted[nonceSize:], []byte(claims.ID))
+	if err != nil {
+		return nil, amizone.Credentials{}, ErrInvalidToken
+	}
+	username, password, ok := strings.Cut(string(plaintext), "\x00")
+	if !ok || username != claims.Subject {
+		return nil, amizone.Credentials{}, ErrInvalidToken
+	}
+	return &claims, amizone.Credentials{Username: username, Password: password}, nil
+}
+
+// revoke revokes the token with claims, so that it fails verification from here on. The token is revoked even
+// if persisting the revocation fails, but only until the server restarts.
+func (t *tokenAuthority) revoke(claims *tokenClaims) error {
+	expiry := claims.ExpiresAt.Time
+	t.mu.Lock()
+	now := time.Now()
+	// Expired tokens fail verification regardless, so there's no need to remember them.
+	for id, expiry := range t.revoked {
+		if now.After(expiry) {
+			delete(t.revoked, id)
+		}
+	}
+	t.revoked[claims.ID] = expiry
+	t.mu.Unlock()
+
+	if t.store == nil {
+		return nil
+	}
+	// Revocations must outlast the tokens they revoke, rather than the store's TTL, where the store allows.
+	if store, ok := t.store.(sessionstore.ExpiringStore); ok {
+		return store.SetUntil(revocationKeyPrefix+claims.ID, nil, expiry)
+	}
+	return t.store.Set(revocationKeyPrefix+claims.ID, nil)
+}
+
+func (t *tokenAuthority) isRevoked(id string) bool {
+	t.mu.Lock()
+	_, revoked := t.revoked[id]
+	t.mu.Unlock()
+	if revoked || t.store == nil {
+		return revoked
+	}
+	// Tokens are taken for revoked when the store fails, rather than for valid.
+	_, err := t.store.Get(revocationKeyPrefix + id)
+	return !errors.Is(err, sessionstore.ErrNotFound)
+}
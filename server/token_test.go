@@ -0,0 +1,107 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ditsuke/go-amizone/amizone"
+	"github.com/ditsuke/go-amizone/amizone/sessionstore"
+	. "github.com/onsi/gomega"
+)
+
+func TestTokenAuthority(t *testing.T) {
+	cred := amizone.Credentials{Username: "8829311", Password: "pass:with\x00odd chars"}
+
+	newAuthority := func(g *WithT, key string, ttl time.Duration) *tokenAuthority {
+		authority, err := newTokenAuthority([]byte(key), ttl, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		return authority
+	}
+
+	t.Run("issued tokens verify", func(t *testing.T) {
+		g := NewWithT(t)
+		authority := newAuthority(g, "key", time.Hour)
+
+		token, expiry, err := authority.issue(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(expiry).To(BeTemporally("~", time.Now().Add(time.Hour), time.Second))
+		g.Expect(token).ToNot(ContainSubstring("pass"), "credentials should be encrypted")
+
+		claims, verifiedCred, err := authority.verify(token)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(verifiedCred).To(Equal(cred))
+		g.Expect(claims.Subject).To(Equal(cred.Username))
+	})
+
+	t.Run("tokens from another key are rejected", func(t *testing.T) {
+		g := NewWithT(t)
+		token, _, err := newAuthority(g, "key", time.Hour).issue(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, _, err = newAuthority(g, "other key", time.Hour).verify(token)
+		g.Expect(err).To(MatchError(ErrInvalidToken))
+	})
+
+	t.Run("tampered tokens are rejected", func(t *testing.T) {
+		g := NewWithT(t)
+		authority := newAuthority(g, "key", time.Hour)
+		token, _, err := authority.issue(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		parts := strings.Split(token, ".")
+		parts[1] = parts[1][:len(parts[1])-2] + "AA"
+		_, _, err = authority.verify(strings.Join(parts, "."))
+		g.Expect(err).To(MatchError(ErrInvalidToken))
+	})
+
+	t.Run("expired tokens are rejected", func(t *testing.T) {
+		g := NewWithT(t)
+		authority := newAuthority(g, "key", time.Nanosecond)
+		token, _, err := authority.issue(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		time.Sleep(1100 * time.Millisecond)
+		_, _, err = authority.verify(token)
+		g.Expect(err).To(MatchError(ErrInvalidToken))
+	})
+
+	t.Run("revoked tokens are rejected", func(t *testing.T) {
+		g := NewWithT(t)
+		authority := newAuthority(g, "key", time.Hour)
+		token, _, err := authority.issue(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+		other, _, err := authority.issue(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		claims, _, err := authority.verify(token)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(authority.revoke(claims)).To(Succeed())
+
+		_, _, err = authority.verify(token)
+		g.Expect(err).To(MatchError(ErrRevokedToken))
+		_, _, err = authority.verify(other)
+		g.Expect(err).ToNot(HaveOccurred(), "revoking a token shouldn't affect other tokens")
+	})
+
+	t.Run("revocations persist in the store", func(t *testing.T) {
+		g := NewWithT(t)
+		store, err := sessionstore.NewFileStore(t.TempDir(), []byte(strings.Repeat("k", sessionstore.KeySize)), time.Nanosecond)
+		g.Expect(err).ToNot(HaveOccurred())
+		authority, err := newTokenAuthority([]byte("key"), time.Hour, store)
+		g.Expect(err).ToNot(HaveOccurred())
+		token, _, err := authority.issue(cred)
+		g.Expect(err).ToNot(HaveOccurred())
+		claims, _, err := authority.verify(token)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(authority.revoke(claims)).To(Succeed())
+
+		// A restarted server shares the key and the store, but not the memory. Revocations outlast the store's
+		// TTL, since they must outlast the tokens they revoke.
+		time.Sleep(1100 * time.Millisecond)
+		restarted, err := newTokenAuthority([]byte("key"), time.Hour, store)
+		g.Expect(err).ToNot(HaveOccurred())
+		_, _, err = restarted.verify(token)
+		g.Expect(err).To(MatchError(ErrRevokedToken))
+	})
+}